@@ -0,0 +1,222 @@
+package accounts
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	cryptokeyring "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/go-bip39"
+	evmoshd "github.com/evmos/evmos/v17/crypto/hd"
+	"github.com/pkg/errors"
+)
+
+const (
+	// ethCoinType is the BIP44 coin type of Ethereum, that is used for eth_secp256k1 keys.
+	ethCoinType = 60
+	// mnemonicEntropySize is the entropy size in bits of the generated mnemonics, i.e. 24 words.
+	mnemonicEntropySize = 256
+)
+
+// CreateOptions are the options to create test accounts.
+type CreateOptions struct {
+	// Count is the number of accounts to create.
+	Count int
+	// Prefix is the prefix of the account names, which are suffixed with their index, e.g. "user0".
+	Prefix string
+	// Mnemonic is the mnemonic to derive the accounts from.
+	// If empty, the mnemonic is derived from the seed or generated randomly.
+	Mnemonic string
+	// Seed is used to derive a deterministic mnemonic, so that the same accounts are created on every run.
+	Seed string
+	// Fund is the amount sent to every created account. If empty, the accounts are not funded.
+	Fund string
+}
+
+// CreateResult contains the created accounts and the mnemonic they were derived from.
+type CreateResult struct {
+	Accounts []utils.Account
+	Mnemonic string
+}
+
+// Create derives the given number of eth_secp256k1 accounts from a mnemonic, adds them to the keyring
+// and funds them from the sender in a single transaction. The account with index i is derived
+// at the HD path m/44'/60'/0'/0/i. Existing keys holding the derived addresses are reused.
+func Create(bin *utils.Binary, opts CreateOptions) (CreateResult, error) {
+	if opts.Count < 1 {
+		return CreateResult{}, fmt.Errorf("count has to be positive; got %d", opts.Count)
+	}
+
+	mnemonic, err := GetMnemonic(opts.Mnemonic, opts.Seed)
+	if err != nil {
+		return CreateResult{}, err
+	}
+
+	keyringHomes, err := utils.GetKeyringHomes(bin.Config.Home)
+	if err != nil {
+		return CreateResult{}, err
+	}
+
+	// NOTE: for a local testnet, the accounts are added to the keyring of the first node
+	keyringHome := keyringHomes[0]
+
+	kr, err := utils.OpenKeyring(bin, keyringHome)
+	if err != nil {
+		return CreateResult{}, err
+	}
+
+	accounts := make([]utils.Account, 0, opts.Count)
+
+	for i := range opts.Count {
+		account, existing, err := addAccount(bin, kr, fmt.Sprintf("%s%d", opts.Prefix, i), mnemonic, uint32(i))
+		if err != nil {
+			return CreateResult{}, err
+		}
+
+		account.KeyringHome = keyringHome
+		accounts = append(accounts, account)
+
+		// NOTE: existing keys were already loaded into the binary's accounts
+		if !existing {
+			bin.Accounts = append(bin.Accounts, account)
+		}
+	}
+
+	if opts.Fund != "" {
+		if err = Fund(bin, accounts, opts.Fund); err != nil {
+			return CreateResult{}, err
+		}
+	}
+
+	return CreateResult{Accounts: accounts, Mnemonic: mnemonic}, nil
+}
+
+// GetMnemonic returns the given mnemonic, a mnemonic deterministically derived from the given seed
+// or a random mnemonic, if neither is given.
+func GetMnemonic(mnemonic, seed string) (string, error) {
+	switch {
+	case mnemonic != "" && seed != "":
+		return "", errors.New("only one of mnemonic and seed can be given")
+	case mnemonic != "":
+		if !bip39.IsMnemonicValid(mnemonic) {
+			return "", errors.New("invalid mnemonic")
+		}
+
+		return mnemonic, nil
+	case seed != "":
+		entropy := sha256.Sum256([]byte(seed))
+
+		return bip39.NewMnemonic(entropy[:])
+	default:
+		entropy, err := bip39.NewEntropy(mnemonicEntropySize)
+		if err != nil {
+			return "", errors.Wrap(err, "error generating entropy")
+		}
+
+		return bip39.NewMnemonic(entropy)
+	}
+}
+
+// Fund sends the given amount to each of the given accounts from the sender in a single transaction.
+func Fund(bin *utils.Binary, accounts []utils.Account, amount string) error {
+	coins, err := utils.ParseAmount(amount, bin.Config.Denom)
+	if err != nil {
+		return err
+	}
+
+	sender, err := bin.GetSender()
+	if err != nil {
+		return err
+	}
+
+	addresses := make([]string, 0, len(accounts))
+	for _, acc := range accounts {
+		addresses = append(addresses, acc.Address)
+	}
+
+	// NOTE: multi-send requires at least two recipients
+	subcommand := []string{"tx", "bank", "multi-send", sender.Name}
+	if len(addresses) == 1 {
+		subcommand = []string{"tx", "bank", "send", sender.Name}
+	}
+
+	subcommand = append(subcommand, addresses...)
+	subcommand = append(subcommand, coins.String(), "--output", "json")
+
+	bin.Logger.Info().Msgf("funding %d accounts with %s from %s", len(accounts), coins, sender.Name)
+
+	out, err := utils.ExecuteTx(bin, utils.TxArgs{
+		Subcommand: subcommand,
+		From:       sender.Name,
+	})
+	if err != nil {
+		return errors.Wrap(err, "error funding accounts")
+	}
+
+	if bin.Config.DryRun {
+		return nil
+	}
+
+	if _, err = utils.GetTxEvents(bin, out); err != nil {
+		return errors.Wrap(err, "error confirming funding transaction")
+	}
+
+	return nil
+}
+
+// addAccount derives the account with the given index from the mnemonic and adds it to the keyring.
+// If a key with the given name already holds the derived address, the existing key is reused,
+// so that running the creation again with the same mnemonic or seed succeeds.
+// In dry-run mode, the account is only derived and the keyring is not modified.
+func addAccount(
+	bin *utils.Binary, kr cryptokeyring.Keyring, name, mnemonic string, index uint32,
+) (account utils.Account, existing bool, err error) {
+	hdPath := hd.CreateHDPath(ethCoinType, 0, index).String()
+
+	derived, err := deriveAccount(bin, name, mnemonic, hdPath)
+	if err != nil {
+		return utils.Account{}, false, err
+	}
+
+	if record, keyErr := kr.Key(name); keyErr == nil {
+		account, err = utils.NewAccountFromRecord(bin, record)
+		if err != nil {
+			return utils.Account{}, false, err
+		}
+
+		if account.Address != derived.Address {
+			return utils.Account{}, false, fmt.Errorf(
+				"key %q already exists in keyring with a different address: %s", name, account.Address,
+			)
+		}
+
+		return account, true, nil
+	}
+
+	if bin.Config.DryRun {
+		return derived, false, nil
+	}
+
+	record, err := kr.NewAccount(name, mnemonic, cryptokeyring.DefaultBIP39Passphrase, hdPath, evmoshd.EthSecp256k1)
+	if err != nil {
+		return utils.Account{}, false, errors.Wrapf(err, "error adding key %s", name)
+	}
+
+	account, err = utils.NewAccountFromRecord(bin, record)
+
+	return account, false, err
+}
+
+// deriveAccount derives the account at the given HD path from the mnemonic
+// in an in-memory keyring, without modifying the configured keyring.
+func deriveAccount(bin *utils.Binary, name, mnemonic, hdPath string) (utils.Account, error) {
+	kr := cryptokeyring.NewInMemory(bin.Cdc, evmoshd.EthSecp256k1Option())
+
+	record, err := kr.NewAccount(name, mnemonic, cryptokeyring.DefaultBIP39Passphrase, hdPath, evmoshd.EthSecp256k1)
+	if err != nil {
+		return utils.Account{}, errors.Wrapf(err, "error deriving key %s", name)
+	}
+
+	return utils.NewAccountFromRecord(bin, record)
+}
@@ -0,0 +1,137 @@
+package accounts_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/MalteHerrmann/evmos-utils/accounts"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	cryptokeyring "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMnemonic(t *testing.T) {
+	t.Parallel()
+
+	//nolint:lll // line length is okay here
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon art"
+
+	testcases := []struct {
+		name        string
+		mnemonic    string
+		seed        string
+		expMnemonic string
+		expError    bool
+		errContains string
+	}{
+		{
+			name:        "pass - given mnemonic",
+			mnemonic:    mnemonic,
+			expMnemonic: mnemonic,
+		},
+		{
+			name: "pass - random mnemonic",
+		},
+		{
+			name:        "fail - invalid mnemonic",
+			mnemonic:    "abandon abandon",
+			expError:    true,
+			errContains: "invalid mnemonic",
+		},
+		{
+			name:        "fail - mnemonic and seed",
+			mnemonic:    mnemonic,
+			seed:        "ci",
+			expError:    true,
+			errContains: "only one of mnemonic and seed",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := accounts.GetMnemonic(tc.mnemonic, tc.seed)
+			if tc.expError {
+				require.ErrorContains(t, err, tc.errContains, "expected different error")
+
+				return
+			}
+
+			require.NoError(t, err, "expected no error getting mnemonic")
+			require.Len(t, strings.Fields(got), 24, "expected 24 words")
+
+			if tc.expMnemonic != "" {
+				require.Equal(t, tc.expMnemonic, got, "expected different mnemonic")
+			}
+		})
+	}
+}
+
+func TestGetMnemonicDeterministic(t *testing.T) {
+	t.Parallel()
+
+	first, err := accounts.GetMnemonic("", "ci")
+	require.NoError(t, err, "expected no error deriving mnemonic")
+
+	second, err := accounts.GetMnemonic("", "ci")
+	require.NoError(t, err, "expected no error deriving mnemonic")
+	require.Equal(t, first, second, "expected the same mnemonic for the same seed")
+
+	other, err := accounts.GetMnemonic("", "other")
+	require.NoError(t, err, "expected no error deriving mnemonic")
+	require.NotEqual(t, first, other, "expected different mnemonics for different seeds")
+}
+
+func TestCreate(t *testing.T) {
+	t.Parallel()
+
+	cdc, ok := utils.GetCodec()
+	require.True(t, ok, "expected codec to be available")
+
+	bin := &utils.Binary{
+		Cdc:    cdc,
+		Config: utils.BinaryConfig{Home: t.TempDir(), KeyringBackend: cryptokeyring.BackendTest},
+	}
+	opts := accounts.CreateOptions{Count: 3, Prefix: "user", Seed: "ci"}
+
+	result, err := accounts.Create(bin, opts)
+	require.NoError(t, err, "expected no error creating accounts")
+	require.Len(t, result.Accounts, 3, "expected three accounts")
+	require.Equal(t, "user2", result.Accounts[2].Name, "expected accounts to be suffixed with their index")
+	require.Len(t, bin.Accounts, 3, "expected accounts to be added to the binary")
+
+	listed, err := utils.ListAccounts(bin, bin.Config.Home)
+	require.NoError(t, err, "expected no error listing accounts")
+	require.Equal(t, result.Accounts, listed, "expected accounts to be stored in the keyring")
+
+	rerun, err := accounts.Create(bin, opts)
+	require.NoError(t, err, "expected no error creating the same accounts again")
+	require.Equal(t, result.Accounts, rerun.Accounts, "expected existing keys to be reused")
+	require.Len(t, bin.Accounts, 3, "expected existing keys not to be added twice")
+
+	_, err = accounts.Create(bin, accounts.CreateOptions{Count: 1, Prefix: "user", Seed: "other"})
+	require.ErrorContains(t, err, `key "user0" already exists`, "expected error for existing key with other address")
+}
+
+func TestCreateDryRun(t *testing.T) {
+	t.Parallel()
+
+	cdc, ok := utils.GetCodec()
+	require.True(t, ok, "expected codec to be available")
+
+	bin := &utils.Binary{
+		Cdc: cdc,
+		Config: utils.BinaryConfig{
+			Home: t.TempDir(), KeyringBackend: cryptokeyring.BackendTest, DryRun: true,
+		},
+	}
+
+	result, err := accounts.Create(bin, accounts.CreateOptions{Count: 2, Prefix: "user", Seed: "ci"})
+	require.NoError(t, err, "expected no error creating accounts in dry-run mode")
+	require.Len(t, result.Accounts, 2, "expected derived accounts to be returned")
+
+	listed, err := utils.ListAccounts(bin, bin.Config.Home)
+	require.NoError(t, err, "expected no error listing accounts")
+	require.Empty(t, listed, "expected no keys to be written in dry-run mode")
+}
@@ -0,0 +1,186 @@
+package accounts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+const (
+	// balanceOfSelector is the function selector of the ERC20 balanceOf(address) method.
+	balanceOfSelector = "0x70a08231"
+	// rpcTimeout is the maximum time to wait for a response of the JSON-RPC server.
+	rpcTimeout = 10 * time.Second
+)
+
+// TokenPair is a pair of a Cosmos coin and an ERC20 token registered in the erc20 module.
+type TokenPair struct {
+	// ERC20Address is the hex address of the ERC20 contract.
+	ERC20Address string `json:"erc20_address"`
+	// Denom is the denomination of the Cosmos coin.
+	Denom string `json:"denom"`
+	// Enabled defines whether the conversion between the coin and the token is enabled.
+	Enabled bool `json:"enabled"`
+}
+
+// ERC20Balance is the balance of an ERC20 token.
+type ERC20Balance struct {
+	// Denom is the denomination of the Cosmos coin paired with the token.
+	Denom string `json:"denom"`
+	// Contract is the hex address of the ERC20 contract.
+	Contract string `json:"contract"`
+	// Amount is the token balance. It is nil if the balance could not be queried.
+	Amount *big.Int `json:"amount"`
+	// Error is the error that occurred while querying the balance, e.g. if the JSON-RPC server is not reachable.
+	Error string `json:"error,omitempty"`
+}
+
+// String returns the balance in the format of a coin, e.g. "100aevmos",
+// or a placeholder if the balance could not be queried, e.g. "?aevmos".
+func (b ERC20Balance) String() string {
+	if b.Error != "" {
+		return "?" + b.Denom
+	}
+
+	return b.Amount.String() + b.Denom
+}
+
+// GetERC20Balance returns the balance of the given holder in the ERC20 token of the given pair.
+// If the query fails, the error is recorded in the returned balance instead of being returned,
+// so that a single failing contract or an unreachable JSON-RPC server does not fail the other queries.
+func GetERC20Balance(rpcURL string, pair TokenPair, holder string) ERC20Balance {
+	balance := ERC20Balance{Denom: pair.Denom, Contract: pair.ERC20Address}
+
+	amount, err := QueryERC20Balance(rpcURL, pair.ERC20Address, holder)
+	if err != nil {
+		balance.Error = err.Error()
+
+		return balance
+	}
+
+	balance.Amount = amount
+
+	return balance
+}
+
+// QueryTokenPairs returns the token pairs registered in the erc20 module.
+func QueryTokenPairs(bin *utils.Binary) ([]TokenPair, error) {
+	out, err := utils.ExecuteQuery(bin, utils.QueryArgs{
+		Subcommand: []string{"q", "erc20", "token-pairs", "--output=json"},
+		Quiet:      true,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error querying token pairs: %s", out)
+	}
+
+	return ParseTokenPairs(out)
+}
+
+// ParseTokenPairs parses the token pairs from the given output of the token pairs query.
+func ParseTokenPairs(out string) ([]TokenPair, error) {
+	var res struct {
+		TokenPairs []TokenPair `json:"token_pairs"`
+	}
+
+	if err := json.Unmarshal([]byte(out), &res); err != nil {
+		return nil, fmt.Errorf("error unmarshalling token pairs: %w", err)
+	}
+
+	return res.TokenPairs, nil
+}
+
+// GetEnabledTokenPairs returns the token pairs, for which the conversion is enabled.
+func GetEnabledTokenPairs(pairs []TokenPair) []TokenPair {
+	enabled := make([]TokenPair, 0, len(pairs))
+
+	for _, pair := range pairs {
+		if pair.Enabled {
+			enabled = append(enabled, pair)
+		}
+	}
+
+	return enabled
+}
+
+// QueryERC20Balance returns the balance of the given holder in the given ERC20 contract
+// by calling balanceOf through the JSON-RPC server.
+func QueryERC20Balance(rpcURL, contract, holder string) (amount *big.Int, err error) {
+	if !common.IsHexAddress(holder) {
+		return nil, fmt.Errorf("invalid hex address %q", holder)
+	}
+
+	// NOTE: the address is left-padded to 32 bytes as the argument of balanceOf
+	data := balanceOfSelector + strings.Repeat("0", 24) + strings.TrimPrefix(strings.ToLower(holder), "0x")
+
+	request, err := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "eth_call",
+		"params":  []interface{}{map[string]string{"to": contract, "data": data}, "latest"},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error encoding request")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, rpcURL, bytes.NewReader(request))
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating request")
+	}
+
+	httpRequest.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(httpRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "error calling JSON-RPC server")
+	}
+
+	defer func() {
+		if closeErr := resp.Body.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	var response struct {
+		Result string `json:"result"`
+		Error  *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+
+	if err = json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, errors.Wrap(err, "error decoding response")
+	}
+
+	if response.Error != nil {
+		return nil, fmt.Errorf("eth_call failed: %s", response.Error.Message)
+	}
+
+	return ParseUint256(response.Result)
+}
+
+// ParseUint256 parses the given hex encoded return value of a contract call.
+func ParseUint256(result string) (*big.Int, error) {
+	result = strings.TrimPrefix(result, "0x")
+	if result == "" {
+		return nil, errors.New("empty result; the contract might not exist")
+	}
+
+	amount, ok := new(big.Int).SetString(result, 16)
+	if !ok {
+		return nil, fmt.Errorf("invalid uint256 %q", result)
+	}
+
+	return amount, nil
+}
@@ -0,0 +1,216 @@
+package accounts
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	cryptokeyring "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/pkg/errors"
+)
+
+// exportPassphrase is the passphrase, that the private keys are temporarily encrypted with while exporting.
+const exportPassphrase = "evmos-utils-export"
+
+// ExportFormats are the supported formats to export the accounts in.
+var ExportFormats = []string{"hardhat", "foundry", "env", "metamask"}
+
+// chainIDPattern matches Evmos chain IDs, e.g. "evmos_9000-1", and captures the EVM chain ID.
+var chainIDPattern = regexp.MustCompile(`^[a-z]+_(\d+)-\d+$`)
+
+// envUnsafeChars matches characters, that are not allowed in the names of environment variables.
+var envUnsafeChars = regexp.MustCompile(`\W`)
+
+// ExportedKey is the private key of an account in the keyring.
+type ExportedKey struct {
+	// Name is the name of the key.
+	Name string `json:"name"`
+	// Address is the hex address of the account.
+	Address string `json:"address"`
+	// PrivateKey is the hex encoded private key with the 0x prefix.
+	PrivateKey string `json:"privateKey"`
+}
+
+// ExportOptions are the options to export the accounts.
+type ExportOptions struct {
+	// Format is the format to export the accounts in.
+	Format string
+	// RPCURL is the URL of the JSON-RPC server of the node.
+	RPCURL string
+}
+
+// GetEVMChainID returns the EVM chain ID contained in the given Evmos chain ID, e.g. 9000 for "evmos_9000-1".
+func GetEVMChainID(chainID string) (int64, error) {
+	match := chainIDPattern.FindStringSubmatch(chainID)
+	if len(match) < 2 {
+		return 0, fmt.Errorf("invalid chain ID %q; expected a chain ID like evmos_9000-1", chainID)
+	}
+
+	return strconv.ParseInt(match[1], 10, 64)
+}
+
+// ExportKeys returns the private keys of the selected accounts. To prevent leaking keys of real funds,
+// this is only possible for the test keyring backend.
+func ExportKeys(bin *utils.Binary) ([]ExportedKey, error) {
+	if bin.Config.KeyringBackend != cryptokeyring.BackendTest {
+		return nil, fmt.Errorf(
+			"exporting private keys is only supported for the %q keyring backend", cryptokeyring.BackendTest,
+		)
+	}
+
+	accounts, err := bin.GetSelectedAccounts()
+	if err != nil {
+		return nil, err
+	}
+
+	keyrings := make(map[string]cryptokeyring.Keyring)
+	keys := make([]ExportedKey, 0, len(accounts))
+
+	for _, acc := range accounts {
+		kr, ok := keyrings[acc.KeyringHome]
+		if !ok {
+			if kr, err = utils.OpenKeyring(bin, acc.KeyringHome); err != nil {
+				return nil, err
+			}
+
+			keyrings[acc.KeyringHome] = kr
+		}
+
+		privateKey, err := exportPrivateKey(kr, acc.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, ExportedKey{Name: acc.Name, Address: acc.HexAddress, PrivateKey: privateKey})
+	}
+
+	return keys, nil
+}
+
+// FormatKeys returns a configuration snippet in the given format, that contains the given keys,
+// the JSON-RPC URL and the EVM chain ID.
+func FormatKeys(keys []ExportedKey, opts ExportOptions, evmChainID int64) (string, error) {
+	switch opts.Format {
+	case "hardhat":
+		return formatHardhat(keys, opts.RPCURL, evmChainID), nil
+	case "foundry":
+		return formatFoundry(keys, opts.RPCURL, evmChainID), nil
+	case "env":
+		return formatEnv(keys, opts.RPCURL, evmChainID), nil
+	case "metamask":
+		return formatMetamask(keys, opts.RPCURL, evmChainID)
+	default:
+		return "", fmt.Errorf("invalid format %q; please use one of %s", opts.Format, strings.Join(ExportFormats, ", "))
+	}
+}
+
+// exportPrivateKey returns the hex encoded private key of the given key in the keyring.
+func exportPrivateKey(kr cryptokeyring.Keyring, name string) (string, error) {
+	armor, err := kr.ExportPrivKeyArmor(name, exportPassphrase)
+	if err != nil {
+		return "", errors.Wrapf(err, "error exporting key %s", name)
+	}
+
+	privKey, _, err := crypto.UnarmorDecryptPrivKey(armor, exportPassphrase)
+	if err != nil {
+		return "", errors.Wrapf(err, "error decrypting key %s", name)
+	}
+
+	return "0x" + hex.EncodeToString(privKey.Bytes()), nil
+}
+
+// formatHardhat returns a network entry for the Hardhat configuration.
+func formatHardhat(keys []ExportedKey, rpcURL string, evmChainID int64) string {
+	var builder strings.Builder
+
+	builder.WriteString("evmos: {\n")
+	builder.WriteString(fmt.Sprintf("  url: %q,\n", rpcURL))
+	builder.WriteString(fmt.Sprintf("  chainId: %d,\n", evmChainID))
+	builder.WriteString("  accounts: [\n")
+
+	for _, key := range keys {
+		builder.WriteString(fmt.Sprintf("    %q, // %s (%s)\n", key.PrivateKey, key.Name, key.Address))
+	}
+
+	builder.WriteString("  ],\n},")
+
+	return builder.String()
+}
+
+// formatFoundry returns the RPC endpoint for the Foundry configuration
+// and the commands to import the keys into the Foundry keystore.
+func formatFoundry(keys []ExportedKey, rpcURL string, evmChainID int64) string {
+	var builder strings.Builder
+
+	builder.WriteString("# foundry.toml\n")
+	builder.WriteString("[rpc_endpoints]\n")
+	builder.WriteString(fmt.Sprintf("evmos = %q # chain ID %d\n", rpcURL, evmChainID))
+
+	builder.WriteString("\n# import the keys into the Foundry keystore\n")
+
+	for _, key := range keys {
+		builder.WriteString(fmt.Sprintf("# %s\n", key.Address))
+		builder.WriteString(fmt.Sprintf("cast wallet import %s --private-key %s\n", key.Name, key.PrivateKey))
+	}
+
+	return strings.TrimSuffix(builder.String(), "\n")
+}
+
+// formatEnv returns the keys as environment variables, e.g. for a .env file.
+func formatEnv(keys []ExportedKey, rpcURL string, evmChainID int64) string {
+	lines := []string{
+		"RPC_URL=" + rpcURL,
+		fmt.Sprintf("CHAIN_ID=%d", evmChainID),
+	}
+
+	for _, key := range keys {
+		name := strings.ToUpper(envUnsafeChars.ReplaceAllString(key.Name, "_"))
+		lines = append(lines,
+			fmt.Sprintf("%s_ADDRESS=%s", name, key.Address),
+			fmt.Sprintf("%s_PRIVATE_KEY=%s", name, key.PrivateKey),
+		)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// formatMetamask returns the network to add to MetaMask and the keys to import as JSON.
+func formatMetamask(keys []ExportedKey, rpcURL string, evmChainID int64) (string, error) {
+	type nativeCurrency struct {
+		Name     string `json:"name"`
+		Symbol   string `json:"symbol"`
+		Decimals int    `json:"decimals"`
+	}
+
+	type network struct {
+		ChainID        string         `json:"chainId"`
+		ChainName      string         `json:"chainName"`
+		RPCURLs        []string       `json:"rpcUrls"`
+		NativeCurrency nativeCurrency `json:"nativeCurrency"`
+	}
+
+	export := struct {
+		Network  network       `json:"network"`
+		Accounts []ExportedKey `json:"accounts"`
+	}{
+		Network: network{
+			ChainID:        "0x" + strconv.FormatInt(evmChainID, 16),
+			ChainName:      fmt.Sprintf("Evmos Local (%d)", evmChainID),
+			RPCURLs:        []string{rpcURL},
+			NativeCurrency: nativeCurrency{Name: "Evmos", Symbol: "EVMOS", Decimals: 18},
+		},
+		Accounts: keys,
+	}
+
+	bz, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return "", errors.Wrap(err, "error marshalling MetaMask export")
+	}
+
+	return string(bz), nil
+}
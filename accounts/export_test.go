@@ -0,0 +1,125 @@
+package accounts_test
+
+import (
+	"testing"
+
+	"github.com/MalteHerrmann/evmos-utils/accounts"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	cryptokeyring "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetEVMChainID(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name       string
+		chainID    string
+		expChainID int64
+		expError   bool
+	}{
+		{name: "pass - local node", chainID: "evmos_9000-1", expChainID: 9000},
+		{name: "pass - mainnet", chainID: "evmos_9001-2", expChainID: 9001},
+		{name: "fail - no EVM chain ID", chainID: "cosmoshub-4", expError: true},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			evmChainID, err := accounts.GetEVMChainID(tc.chainID)
+			if tc.expError {
+				require.ErrorContains(t, err, "invalid chain ID", "expected different error")
+
+				return
+			}
+
+			require.NoError(t, err, "expected no error getting EVM chain ID")
+			require.Equal(t, tc.expChainID, evmChainID, "expected different EVM chain ID")
+		})
+	}
+}
+
+func TestFormatKeys(t *testing.T) {
+	t.Parallel()
+
+	keys := []accounts.ExportedKey{{
+		Name:       "dev-0",
+		Address:    "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		PrivateKey: "0x0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+	}}
+
+	testcases := []struct {
+		format      string
+		expContains []string
+		expError    bool
+	}{
+		{
+			format:      "hardhat",
+			expContains: []string{`url: "http://localhost:8545"`, "chainId: 9000", `"` + keys[0].PrivateKey + `", // dev-0`},
+		},
+		{
+			format:      "foundry",
+			expContains: []string{`evmos = "http://localhost:8545"`, "cast wallet import dev-0 --private-key 0x0123"},
+		},
+		{
+			format:      "env",
+			expContains: []string{"CHAIN_ID=9000", "DEV_0_PRIVATE_KEY=0x0123", "DEV_0_ADDRESS=0x5aAeb"},
+		},
+		{
+			format:      "metamask",
+			expContains: []string{`"chainId": "0x2328"`, `"privateKey": "0x0123`},
+		},
+		{
+			format:   "invalid",
+			expError: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.format, func(t *testing.T) {
+			t.Parallel()
+
+			opts := accounts.ExportOptions{Format: tc.format, RPCURL: "http://localhost:8545"}
+
+			out, err := accounts.FormatKeys(keys, opts, 9000)
+			if tc.expError {
+				require.ErrorContains(t, err, "invalid format", "expected different error")
+
+				return
+			}
+
+			require.NoError(t, err, "expected no error formatting keys")
+
+			for _, expected := range tc.expContains {
+				require.Contains(t, out, expected, "expected different output")
+			}
+		})
+	}
+}
+
+func TestExportKeys(t *testing.T) {
+	t.Parallel()
+
+	cdc, ok := utils.GetCodec()
+	require.True(t, ok, "expected codec to be available")
+
+	bin := &utils.Binary{
+		Cdc:    cdc,
+		Config: utils.BinaryConfig{Home: t.TempDir(), KeyringBackend: cryptokeyring.BackendTest},
+	}
+
+	result, err := accounts.Create(bin, accounts.CreateOptions{Count: 2, Prefix: "user", Seed: "ci"})
+	require.NoError(t, err, "expected no error creating accounts")
+
+	keys, err := accounts.ExportKeys(bin)
+	require.NoError(t, err, "expected no error exporting keys")
+	require.Len(t, keys, 2, "expected two keys")
+	require.Equal(t, result.Accounts[0].HexAddress, keys[0].Address, "expected hex address")
+	require.Regexp(t, "^0x[0-9a-f]{64}$", keys[0].PrivateKey, "expected hex encoded private key")
+
+	bin.Config.KeyringBackend = cryptokeyring.BackendFile
+
+	_, err = accounts.ExportKeys(bin)
+	require.ErrorContains(t, err, "only supported for the \"test\" keyring backend")
+}
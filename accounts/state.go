@@ -0,0 +1,246 @@
+package accounts
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/MalteHerrmann/evmos-utils/snapshot"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
+)
+
+// maxConcurrentQueries is the maximum number of queries, that are executed concurrently.
+const maxConcurrentQueries = 8
+
+// State is the on-chain state of a keyring account.
+type State struct {
+	// Name is the name of the key.
+	Name string `json:"name"`
+	// Address is the bech32 address of the account.
+	Address string `json:"address"`
+	// HexAddress is the hex address of the account.
+	HexAddress string `json:"hex_address"`
+	// Balances are the bank balances of the account.
+	Balances sdk.Coins `json:"balances"`
+	// ERC20Balances are the balances of the ERC20 tokens registered in the erc20 module.
+	ERC20Balances []ERC20Balance `json:"erc20_balances"`
+	// Delegations are the delegations of the account.
+	Delegations []stakingtypes.DelegationResponse `json:"delegations"`
+	// Unbondings are the unbonding delegations of the account.
+	Unbondings []stakingtypes.UnbondingDelegation `json:"unbondings"`
+	// Rewards are the pending staking rewards of the account.
+	Rewards sdk.DecCoins `json:"rewards"`
+	// VestingType is the type of the vesting account. It is empty for accounts without vesting.
+	VestingType string `json:"vesting_type,omitempty"`
+	// Nonce is the EVM nonce of the account.
+	Nonce uint64 `json:"nonce"`
+}
+
+// GetDelegated returns the total amount delegated by the account.
+func (s State) GetDelegated() sdk.Coins {
+	delegated := sdk.NewCoins()
+	for _, delegation := range s.Delegations {
+		delegated = delegated.Add(delegation.Balance)
+	}
+
+	return delegated
+}
+
+// GetUnbonding returns the total amount currently unbonding from the account's delegations
+// in the given bond denomination.
+func (s State) GetUnbonding(bondDenom string) sdk.Coins {
+	unbonding := sdk.NewCoins()
+
+	for _, ubd := range s.Unbondings {
+		for _, entry := range ubd.Entries {
+			unbonding = unbonding.Add(sdk.NewCoin(bondDenom, entry.Balance))
+		}
+	}
+
+	return unbonding
+}
+
+// GetStates queries the on-chain state of the selected accounts. All queries are executed concurrently.
+// If the JSON-RPC URL is empty, the ERC20 balances are not queried. Only the balances of enabled
+// token pairs are queried and failing balance queries are recorded in the respective ERC20 balance.
+func GetStates(bin *utils.Binary, rpcURL string) ([]State, error) {
+	accounts, err := bin.GetSelectedAccounts()
+	if err != nil {
+		return nil, err
+	}
+
+	var tokenPairs []TokenPair
+
+	if rpcURL != "" {
+		if tokenPairs, err = QueryTokenPairs(bin); err != nil {
+			return nil, err
+		}
+
+		tokenPairs = GetEnabledTokenPairs(tokenPairs)
+	}
+
+	states := make([]State, len(accounts))
+
+	var group errgroup.Group
+
+	group.SetLimit(maxConcurrentQueries)
+
+	for i, acc := range accounts {
+		state := &states[i]
+		state.Name = acc.Name
+		state.Address = acc.Address
+		state.HexAddress = acc.HexAddress
+
+		group.Go(func() error {
+			return queryInto(bin, []string{"q", "bank", "balances", acc.Address}, func(out string) (err error) {
+				state.Balances, err = snapshot.ParseBalancesFromResponse(bin.Cdc, out)
+
+				return err
+			})
+		})
+		group.Go(func() error {
+			return queryInto(bin, []string{"q", "staking", "delegations", acc.Address}, func(out string) error {
+				var res stakingtypes.QueryDelegatorDelegationsResponse
+				if err := bin.Cdc.UnmarshalJSON([]byte(out), &res); err != nil {
+					return fmt.Errorf("error unmarshalling delegations: %w", err)
+				}
+
+				state.Delegations = res.DelegationResponses
+
+				return nil
+			})
+		})
+		group.Go(func() error {
+			subcommand := []string{"q", "staking", "unbonding-delegations", acc.Address}
+
+			return queryInto(bin, subcommand, func(out string) error {
+				var res stakingtypes.QueryDelegatorUnbondingDelegationsResponse
+				if err := bin.Cdc.UnmarshalJSON([]byte(out), &res); err != nil {
+					return fmt.Errorf("error unmarshalling unbonding delegations: %w", err)
+				}
+
+				state.Unbondings = res.UnbondingResponses
+
+				return nil
+			})
+		})
+		group.Go(func() error {
+			return queryInto(bin, []string{"q", "distribution", "rewards", acc.Address}, func(out string) error {
+				var res distrtypes.QueryDelegationTotalRewardsResponse
+				if err := bin.Cdc.UnmarshalJSON([]byte(out), &res); err != nil {
+					return fmt.Errorf("error unmarshalling rewards: %w", err)
+				}
+
+				state.Rewards = res.Total
+
+				return nil
+			})
+		})
+		group.Go(func() error {
+			return queryVestingType(bin, acc.Address, state)
+		})
+		group.Go(func() error {
+			return queryInto(bin, []string{"q", "evm", "account", acc.HexAddress}, func(out string) (err error) {
+				state.Nonce, err = ParseNonce(out)
+
+				return err
+			})
+		})
+
+		state.ERC20Balances = make([]ERC20Balance, len(tokenPairs))
+
+		for j, pair := range tokenPairs {
+			balance := &state.ERC20Balances[j]
+
+			group.Go(func() error {
+				*balance = GetERC20Balance(rpcURL, pair, acc.HexAddress)
+				if balance.Error != "" {
+					bin.Logger.Warn().Msgf("error querying %s balance of %s: %s", pair.Denom, acc.Name, balance.Error)
+				}
+
+				return nil
+			})
+		}
+	}
+
+	if err = group.Wait(); err != nil {
+		return nil, err
+	}
+
+	return states, nil
+}
+
+// ParseVestingType returns the type of the vesting account from the given output of the auth account query,
+// e.g. "ClawbackVestingAccount". It is empty for accounts without vesting.
+func ParseVestingType(out string) (string, error) {
+	var account struct {
+		Type string `json:"@type"`
+	}
+
+	if err := json.Unmarshal([]byte(out), &account); err != nil {
+		return "", fmt.Errorf("error unmarshalling account: %w", err)
+	}
+
+	typeName := account.Type[strings.LastIndex(account.Type, ".")+1:]
+	if !strings.Contains(typeName, "Vesting") {
+		return "", nil
+	}
+
+	return typeName, nil
+}
+
+// ParseNonce returns the nonce from the given output of the EVM account query.
+func ParseNonce(out string) (uint64, error) {
+	var account struct {
+		Nonce json.Number `json:"nonce"`
+	}
+
+	if err := json.Unmarshal([]byte(out), &account); err != nil {
+		return 0, fmt.Errorf("error unmarshalling EVM account: %w", err)
+	}
+
+	if account.Nonce == "" {
+		return 0, nil
+	}
+
+	return strconv.ParseUint(account.Nonce.String(), 10, 64)
+}
+
+// queryVestingType queries the type of the vesting account. Accounts, that do not exist on chain yet,
+// have no vesting.
+func queryVestingType(bin *utils.Binary, address string, state *State) error {
+	out, err := utils.ExecuteQuery(bin, utils.QueryArgs{
+		Subcommand: []string{"q", "auth", "account", address, "--output=json"},
+		Quiet:      true,
+	})
+	if err != nil {
+		if strings.Contains(out, "not found") {
+			return nil
+		}
+
+		return errors.Wrapf(err, "error querying account %s: %s", address, out)
+	}
+
+	state.VestingType, err = ParseVestingType(out)
+
+	return err
+}
+
+// queryInto executes the given query with JSON output and passes the output to the given parse function.
+func queryInto(bin *utils.Binary, subcommand []string, parse func(out string) error) error {
+	out, err := utils.ExecuteQuery(bin, utils.QueryArgs{
+		Subcommand: append(subcommand, "--output=json"),
+		Quiet:      true,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "error executing %q: %s", strings.Join(subcommand, " "), out)
+	}
+
+	return parse(out)
+}
@@ -0,0 +1,178 @@
+package accounts_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/MalteHerrmann/evmos-utils/accounts"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVestingType(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		out         string
+		expType     string
+		expError    bool
+		errContains string
+	}{
+		{
+			name:    "pass - vesting account",
+			out:     `{"@type":"/evmos.vesting.v2.ClawbackVestingAccount","base_vesting_account":{}}`,
+			expType: "ClawbackVestingAccount",
+		},
+		{
+			name: "pass - no vesting",
+			out:  `{"@type":"/ethermint.types.v1.EthAccount","base_account":{}}`,
+		},
+		{
+			name:        "fail - invalid output",
+			out:         "invalid",
+			expError:    true,
+			errContains: "error unmarshalling account",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			vestingType, err := accounts.ParseVestingType(tc.out)
+			if tc.expError {
+				require.ErrorContains(t, err, tc.errContains, "expected different error")
+
+				return
+			}
+
+			require.NoError(t, err, "expected no error parsing vesting type")
+			require.Equal(t, tc.expType, vestingType, "expected different vesting type")
+		})
+	}
+}
+
+func TestParseNonce(t *testing.T) {
+	t.Parallel()
+
+	nonce, err := accounts.ParseNonce(`{"balance":"0","code_hash":"0xc5d2","nonce":"12"}`)
+	require.NoError(t, err, "expected no error parsing nonce")
+	require.Equal(t, uint64(12), nonce, "expected different nonce")
+
+	nonce, err = accounts.ParseNonce(`{"balance":"0","code_hash":"0xc5d2"}`)
+	require.NoError(t, err, "expected no error parsing missing nonce")
+	require.Zero(t, nonce, "expected zero nonce")
+}
+
+func TestParseTokenPairs(t *testing.T) {
+	t.Parallel()
+
+	//nolint:lll // line length is okay here
+	out := `{"token_pairs":[{"erc20_address":"0xD4949664cD82660AaE99bEdc034a0deA8A0bd517","denom":"aevmos","enabled":true,"contract_owner":"OWNER_MODULE"}],"pagination":{"next_key":null,"total":"1"}}`
+
+	pairs, err := accounts.ParseTokenPairs(out)
+	require.NoError(t, err, "expected no error parsing token pairs")
+	require.Equal(t, []accounts.TokenPair{{
+		ERC20Address: "0xD4949664cD82660AaE99bEdc034a0deA8A0bd517",
+		Denom:        "aevmos",
+		Enabled:      true,
+	}}, pairs, "expected different token pairs")
+}
+
+func TestQueryERC20Balance(t *testing.T) {
+	t.Parallel()
+
+	holder := "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+
+		var call struct {
+			Data string `json:"data"`
+		}
+
+		data := "0x70a08231000000000000000000000000" + "5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"
+
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil || request.Method != "eth_call" ||
+			len(request.Params) != 2 || json.Unmarshal(request.Params[0], &call) != nil || call.Data != data {
+			_, _ = fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"error":{"message":"unexpected request"}}`)
+
+			return
+		}
+
+		result := "0x" + strings.Repeat("0", 61) + "3e8"
+		_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":%q}`, result)
+	}))
+	defer server.Close()
+
+	amount, err := accounts.QueryERC20Balance(server.URL, "0xD4949664cD82660AaE99bEdc034a0deA8A0bd517", holder)
+	require.NoError(t, err, "expected no error querying balance")
+	require.Equal(t, int64(1000), amount.Int64(), "expected different balance")
+
+	_, err = accounts.QueryERC20Balance(server.URL, "0xD4949664cD82660AaE99bEdc034a0deA8A0bd517", "evmos1invalid")
+	require.ErrorContains(t, err, "invalid hex address", "expected error for invalid holder")
+}
+
+func TestGetEnabledTokenPairs(t *testing.T) {
+	t.Parallel()
+
+	pairs := []accounts.TokenPair{
+		{ERC20Address: "0xD4949664cD82660AaE99bEdc034a0deA8A0bd517", Denom: "aevmos", Enabled: true},
+		{ERC20Address: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", Denom: "ibc/ABC", Enabled: false},
+	}
+
+	require.Equal(t, pairs[:1], accounts.GetEnabledTokenPairs(pairs), "expected disabled pair to be skipped")
+}
+
+func TestGetERC20Balance(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprint(w, `{"jsonrpc":"2.0","id":1,"error":{"message":"execution reverted"}}`)
+	}))
+	defer server.Close()
+
+	pair := accounts.TokenPair{ERC20Address: "0xD4949664cD82660AaE99bEdc034a0deA8A0bd517", Denom: "aevmos"}
+	holder := "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+
+	balance := accounts.GetERC20Balance(server.URL, pair, holder)
+	require.Contains(t, balance.Error, "execution reverted", "expected error to be recorded")
+	require.Nil(t, balance.Amount, "expected no amount for failed query")
+	require.Equal(t, "?aevmos", balance.String(), "expected placeholder for failed query")
+
+	// NOTE: the server is closed to simulate a node with the JSON-RPC server disabled
+	server.Close()
+
+	balance = accounts.GetERC20Balance(server.URL, pair, holder)
+	require.NotEmpty(t, balance.Error, "expected error for unreachable JSON-RPC server")
+	require.Equal(t, pair.ERC20Address, balance.Contract, "expected contract to be kept")
+}
+
+func TestStateTotals(t *testing.T) {
+	t.Parallel()
+
+	state := accounts.State{
+		Delegations: []stakingtypes.DelegationResponse{
+			{Balance: sdk.NewInt64Coin("aevmos", 100)},
+			{Balance: sdk.NewInt64Coin("aevmos", 50)},
+		},
+		Unbondings: []stakingtypes.UnbondingDelegation{{
+			Entries: []stakingtypes.UnbondingDelegationEntry{
+				{Balance: sdk.NewInt(10)},
+				{Balance: sdk.NewInt(5)},
+			},
+		}},
+	}
+
+	require.Equal(t, "150aevmos", state.GetDelegated().String(), "expected different delegated amount")
+	require.Equal(t, "15aevmos", state.GetUnbonding("aevmos").String(), "expected different unbonding amount")
+}
@@ -0,0 +1,174 @@
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// CreateArchive writes the contents of the given directory as a gzip-compressed tarball
+// to the given path. Files, whose paths relative to the directory are contained in exclude,
+// are skipped.
+func CreateArchive(dir, path string, exclude []string) (err error) {
+	//#nosec G304 // path is built from the configured home directory
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return errors.Wrap(err, "error creating archive")
+	}
+
+	defer func() {
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	gzipWriter := gzip.NewWriter(file)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	if err = filepath.Walk(dir, func(filePath string, info os.FileInfo, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+
+		relPath, relErr := filepath.Rel(dir, filePath)
+		if relErr != nil || relPath == "." {
+			return relErr
+		}
+
+		for _, excluded := range exclude {
+			if relPath == excluded {
+				return nil
+			}
+		}
+
+		return addToArchive(tarWriter, filePath, relPath, info)
+	}); err != nil {
+		return errors.Wrap(err, "error archiving directory")
+	}
+
+	if err = tarWriter.Close(); err != nil {
+		return errors.Wrap(err, "error closing archive")
+	}
+
+	return errors.Wrap(gzipWriter.Close(), "error compressing archive")
+}
+
+// addToArchive adds the file at the given path to the archive under the given relative path.
+func addToArchive(tarWriter *tar.Writer, filePath, relPath string, info os.FileInfo) error {
+	var link string
+
+	if info.Mode()&os.ModeSymlink != 0 {
+		var err error
+		if link, err = os.Readlink(filePath); err != nil {
+			return err
+		}
+	}
+
+	header, err := tar.FileInfoHeader(info, link)
+	if err != nil {
+		return err
+	}
+
+	header.Name = filepath.ToSlash(relPath)
+
+	if err = tarWriter.WriteHeader(header); err != nil {
+		return err
+	}
+
+	if !info.Mode().IsRegular() {
+		return nil
+	}
+
+	//#nosec G304 // file is part of the archived directory
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(tarWriter, file)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
+
+// ExtractArchive extracts the gzip-compressed tarball at the given path into the given directory.
+func ExtractArchive(path, dir string) (err error) {
+	//#nosec G304 // path is built from the configured home directory
+	file, err := os.Open(path)
+	if err != nil {
+		return errors.Wrap(err, "error opening archive")
+	}
+
+	defer func() {
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	gzipReader, err := gzip.NewReader(file)
+	if err != nil {
+		return errors.Wrap(err, "error decompressing archive")
+	}
+
+	tarReader := tar.NewReader(gzipReader)
+
+	for {
+		header, nextErr := tarReader.Next()
+		if errors.Is(nextErr, io.EOF) {
+			return nil
+		}
+
+		if nextErr != nil {
+			return errors.Wrap(nextErr, "error reading archive")
+		}
+
+		if err = extractEntry(tarReader, header, dir); err != nil {
+			return errors.Wrapf(err, "error extracting %s", header.Name)
+		}
+	}
+}
+
+// extractEntry extracts a single archive entry into the given directory.
+func extractEntry(tarReader *tar.Reader, header *tar.Header, dir string) error {
+	target := filepath.Join(dir, filepath.FromSlash(header.Name))
+	if !strings.HasPrefix(target, filepath.Clean(dir)+string(os.PathSeparator)) {
+		return fmt.Errorf("invalid path %s in archive", header.Name)
+	}
+
+	mode := os.FileMode(header.Mode).Perm() //#nosec G115 // file modes are within range
+
+	switch header.Typeflag {
+	case tar.TypeDir:
+		return os.MkdirAll(target, mode)
+	case tar.TypeSymlink:
+		return os.Symlink(header.Linkname, target)
+	case tar.TypeReg:
+		if err := os.MkdirAll(filepath.Dir(target), 0o750); err != nil {
+			return err
+		}
+
+		//#nosec G304 // target is checked to be within the directory
+		file, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
+		if err != nil {
+			return err
+		}
+
+		//#nosec G110 // archives are created by the tool itself
+		_, err = io.Copy(file, tarReader)
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+
+		return err
+	default:
+		return fmt.Errorf("unsupported file type %c", header.Typeflag)
+	}
+}
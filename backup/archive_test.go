@@ -0,0 +1,48 @@
+package backup_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/MalteHerrmann/evmos-utils/backup"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchive(t *testing.T) {
+	t.Parallel()
+
+	srcDir := t.TempDir()
+	files := map[string]string{
+		"config/genesis.json":      `{"chain_id": "evmos_9000-1"}`,
+		"data/application.db/LOG":  "log",
+		"keyring-test/dev0.info":   "key",
+		"evmos-utils/node.pid":     "1234",
+		"evmos-utils/journal.json": "{}",
+	}
+
+	for path, contents := range files {
+		fullPath := filepath.Join(srcDir, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(fullPath), 0o750), "unexpected error creating directory")
+		require.NoError(t, os.WriteFile(fullPath, []byte(contents), 0o600), "unexpected error writing file")
+	}
+
+	archivePath := filepath.Join(t.TempDir(), "snapshot.tar.gz")
+	err := backup.CreateArchive(srcDir, archivePath, []string{filepath.Join("evmos-utils", "node.pid")})
+	require.NoError(t, err, "unexpected error creating archive")
+
+	dstDir := t.TempDir()
+	require.NoError(t, backup.ExtractArchive(archivePath, dstDir), "unexpected error extracting archive")
+
+	for path, contents := range files {
+		bz, err := os.ReadFile(filepath.Join(dstDir, path))
+		if path == "evmos-utils/node.pid" {
+			require.True(t, os.IsNotExist(err), "expected excluded file to be missing")
+
+			continue
+		}
+
+		require.NoError(t, err, "unexpected error reading %s", path)
+		require.Equal(t, contents, string(bz), "expected different contents of %s", path)
+	}
+}
@@ -0,0 +1,250 @@
+package backup
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/MalteHerrmann/evmos-utils/node"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/pkg/errors"
+)
+
+// startTimeout is the maximum time to wait for the node to be reachable after it was restarted.
+const startTimeout = time.Minute
+
+// Metadata describes a snapshot of a home directory.
+type Metadata struct {
+	// Name is the name of the snapshot.
+	Name string `json:"name"`
+	// Home is the home directory the snapshot was taken of.
+	Home string `json:"home"`
+	// Height is the latest block height at the time of the snapshot, if the node was reachable.
+	Height int `json:"height"`
+	// BinaryVersion is the version of the binary, that the snapshot was taken with.
+	BinaryVersion string `json:"binary_version"`
+	// CreatedAt is the time the snapshot was taken at.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// GetSnapshotDir returns the directory, in which the snapshots of the configured home directory are stored.
+// It is located next to the home directory, so that it is not affected by restoring a snapshot.
+func GetSnapshotDir(bin *utils.Binary) string {
+	return filepath.Clean(bin.Config.Home) + "-snapshots"
+}
+
+// ValidateName checks that the given snapshot name can be used as a file name
+// in the snapshot directory, so that no files outside of it are written or read.
+func ValidateName(name string) error {
+	if name == "." || name == ".." || filepath.Base(name) != name {
+		return fmt.Errorf("invalid snapshot name %q; must not be empty or contain path separators", name)
+	}
+
+	return nil
+}
+
+// getArchivePath returns the path of the archive of the snapshot with the given name.
+func getArchivePath(bin *utils.Binary, name string) string {
+	return filepath.Join(GetSnapshotDir(bin), name+".tar.gz")
+}
+
+// getMetadataPath returns the path of the metadata of the snapshot with the given name.
+func getMetadataPath(bin *utils.Binary, name string) string {
+	return filepath.Join(GetSnapshotDir(bin), name+".json")
+}
+
+// SaveSnapshot archives the configured home directory including the data, configuration and keyring
+// as a snapshot with the given name. A running node is stopped while the archive is created
+// and started again afterwards.
+func SaveSnapshot(bin *utils.Binary, name string) (Metadata, error) {
+	if err := ValidateName(name); err != nil {
+		return Metadata{}, err
+	}
+
+	if _, err := os.Stat(getArchivePath(bin, name)); err == nil {
+		return Metadata{}, fmt.Errorf("snapshot %s already exists", name)
+	}
+
+	version, err := utils.GetBinaryVersion(bin.Config.Appd)
+	if err != nil {
+		return Metadata{}, err
+	}
+
+	metadata := Metadata{
+		Name:          name,
+		Home:          bin.Config.Home,
+		Height:        node.GetStatus(bin).Height,
+		BinaryVersion: version,
+		CreatedAt:     time.Now().UTC(),
+	}
+
+	err = withStoppedNode(bin, func() error {
+		if err := os.MkdirAll(GetSnapshotDir(bin), 0o750); err != nil {
+			return errors.Wrap(err, "error creating snapshot directory")
+		}
+
+		bin.Logger.Info().Msgf("archiving %s", bin.Config.Home)
+
+		pidFile, err := filepath.Rel(bin.Config.Home, node.GetPIDFilePath(bin))
+		if err != nil {
+			return errors.Wrap(err, "error getting relative path of PID file")
+		}
+
+		if err = CreateArchive(bin.Config.Home, getArchivePath(bin, name), []string{pidFile}); err != nil {
+			return err
+		}
+
+		return utils.WriteJSONFile(getMetadataPath(bin, name), metadata)
+	})
+	if err != nil {
+		return Metadata{}, err
+	}
+
+	return metadata, nil
+}
+
+// RestoreSnapshot replaces the configured home directory with the snapshot with the given name.
+// It refuses to restore the snapshot if it was taken with a different binary version
+// than the configured binary, unless forced. A running node is stopped before restoring
+// the snapshot and started again afterwards.
+func RestoreSnapshot(bin *utils.Binary, name string, force bool) (Metadata, error) {
+	if err := ValidateName(name); err != nil {
+		return Metadata{}, err
+	}
+
+	var metadata Metadata
+	if err := utils.ReadJSONFile(getMetadataPath(bin, name), &metadata); err != nil {
+		return Metadata{}, errors.Wrapf(err, "snapshot %s not found", name)
+	}
+
+	version, err := utils.GetBinaryVersion(bin.Config.Appd)
+	if err != nil {
+		return Metadata{}, err
+	}
+
+	if !force && strings.TrimPrefix(version, "v") != strings.TrimPrefix(metadata.BinaryVersion, "v") {
+		return Metadata{}, fmt.Errorf(
+			"snapshot %s was taken with version %s, but %s has version %s; use --force to restore anyway",
+			name, metadata.BinaryVersion, bin.Config.Appd, version,
+		)
+	}
+
+	err = withStoppedNode(bin, func() error {
+		bin.Logger.Info().Msgf("restoring %s from snapshot %s", bin.Config.Home, name)
+
+		return replaceWithArchive(bin.Config.Home, getArchivePath(bin, name))
+	})
+	if err != nil {
+		return Metadata{}, err
+	}
+
+	return metadata, nil
+}
+
+// replaceWithArchive replaces the given home directory with the contents of the given archive.
+// The archive is extracted into a temporary directory next to the home directory first,
+// which is only swapped in after the extraction succeeded, so that the home directory
+// is kept if the archive cannot be extracted.
+func replaceWithArchive(home, archivePath string) (err error) {
+	home = filepath.Clean(home)
+
+	extractDir, err := os.MkdirTemp(filepath.Dir(home), filepath.Base(home)+"-restore-")
+	if err != nil {
+		return errors.Wrap(err, "error creating temporary directory")
+	}
+
+	// NOTE: after swapping in the restored home directory, the temporary directory does not exist anymore
+	defer func() {
+		if removeErr := os.RemoveAll(extractDir); err == nil {
+			err = removeErr
+		}
+	}()
+
+	if err = ExtractArchive(archivePath, extractDir); err != nil {
+		return err
+	}
+
+	// NOTE: the extracted directory has the permissions of a temporary directory
+	if err = os.Chmod(extractDir, 0o750); err != nil {
+		return errors.Wrap(err, "error setting permissions of restored home directory")
+	}
+
+	oldHome := extractDir + "-old"
+
+	if err = os.Rename(home, oldHome); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "error moving home directory")
+	}
+
+	if err = os.Rename(extractDir, home); err != nil {
+		if restoreErr := os.Rename(oldHome, home); restoreErr != nil && !os.IsNotExist(restoreErr) {
+			return errors.Wrapf(err, "error moving restored home directory; previous home directory is at %s", oldHome)
+		}
+
+		return errors.Wrap(err, "error moving restored home directory")
+	}
+
+	if err = os.RemoveAll(oldHome); err != nil {
+		return errors.Wrap(err, "error removing previous home directory")
+	}
+
+	return nil
+}
+
+// withStoppedNode stops the node process started by the tool, executes the given function
+// and starts the node again if it was running before, also if the function failed.
+func withStoppedNode(bin *utils.Binary, execute func() error) error {
+	wasRunning, err := stopNode(bin)
+	if err != nil {
+		return err
+	}
+
+	err = execute()
+
+	if restartErr := restartNode(bin, wasRunning); restartErr != nil {
+		if err == nil {
+			return restartErr
+		}
+
+		bin.Logger.Error().Msgf("could not restart node: %v", restartErr)
+	}
+
+	return err
+}
+
+// stopNode stops the node process started by the tool and returns whether it was running.
+// It fails if the node was started outside of the tool, because its state is modified
+// while archiving or restoring the home directory.
+func stopNode(bin *utils.Binary) (bool, error) {
+	status := node.GetStatus(bin)
+
+	if !status.Running {
+		if status.RPCReachable {
+			return false, fmt.Errorf("node at %s was not started by evmos-utils; please stop it first", bin.Config.Node)
+		}
+
+		return false, nil
+	}
+
+	bin.Logger.Info().Msgf("stopping node with PID %d", status.PID)
+
+	if err := node.Stop(bin); err != nil {
+		return false, errors.Wrap(err, "error stopping node")
+	}
+
+	return true, nil
+}
+
+// restartNode starts the node again if it was running before.
+func restartNode(bin *utils.Binary, wasRunning bool) error {
+	if !wasRunning {
+		return nil
+	}
+
+	if _, err := node.Start(bin); err != nil {
+		return errors.Wrap(err, "error restarting node")
+	}
+
+	return node.WaitForRPC(bin, startTimeout)
+}
@@ -0,0 +1,89 @@
+package backup_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/MalteHerrmann/evmos-utils/backup"
+	"github.com/MalteHerrmann/evmos-utils/testutil"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestoreSnapshot(t *testing.T) {
+	t.Parallel()
+
+	home := filepath.Join(t.TempDir(), ".evmosd")
+	genesisPath := filepath.Join(home, "config", "genesis.json")
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(genesisPath), 0o750), "unexpected error creating home")
+	require.NoError(t, os.WriteFile(genesisPath, []byte("before"), 0o600), "unexpected error writing genesis")
+
+	bin := &utils.Binary{Config: utils.BinaryConfig{
+		Appd: testutil.WriteFakeBinary(t, t.TempDir(), "v17.0.0"),
+		Home: home,
+		Node: "http://localhost:1",
+	}}
+
+	_, err := backup.SaveSnapshot(bin, "valid")
+	require.NoError(t, err, "unexpected error saving snapshot")
+
+	require.NoError(t, os.WriteFile(genesisPath, []byte("after"), 0o600), "unexpected error writing genesis")
+
+	// NOTE: a truncated archive must not affect the home directory
+	corruptPath := filepath.Join(backup.GetSnapshotDir(bin), "corrupt.tar.gz")
+	require.NoError(t, os.WriteFile(corruptPath, []byte("not an archive"), 0o600), "unexpected error writing archive")
+	require.NoError(t, utils.WriteJSONFile(
+		filepath.Join(backup.GetSnapshotDir(bin), "corrupt.json"), backup.Metadata{BinaryVersion: "v17.0.0"},
+	), "unexpected error writing metadata")
+
+	_, err = backup.RestoreSnapshot(bin, "corrupt", false)
+	require.ErrorContains(t, err, "error decompressing archive", "expected error restoring corrupt snapshot")
+
+	bz, err := os.ReadFile(genesisPath)
+	require.NoError(t, err, "expected home directory to be kept")
+	require.Equal(t, "after", string(bz), "expected home directory to be unchanged")
+
+	entries, err := os.ReadDir(filepath.Dir(home))
+	require.NoError(t, err, "unexpected error reading parent directory")
+	require.Len(t, entries, 2, "expected only home and snapshot directories; got %v", entries)
+
+	_, err = backup.RestoreSnapshot(bin, "valid", false)
+	require.NoError(t, err, "unexpected error restoring snapshot")
+
+	bz, err = os.ReadFile(genesisPath)
+	require.NoError(t, err, "expected genesis to be restored")
+	require.Equal(t, "before", string(bz), "expected restored genesis")
+}
+
+func TestValidateName(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		snapshot string
+		expError bool
+	}{
+		{name: "pass - plain name", snapshot: "pre-upgrade"},
+		{name: "fail - empty", snapshot: "", expError: true},
+		{name: "fail - parent directory", snapshot: "..", expError: true},
+		{name: "fail - relative path", snapshot: "../../x", expError: true},
+		{name: "fail - absolute path", snapshot: "/tmp/x", expError: true},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := backup.ValidateName(tc.snapshot)
+			if tc.expError {
+				require.ErrorContains(t, err, "invalid snapshot name", "expected error for invalid name")
+
+				return
+			}
+
+			require.NoError(t, err, "unexpected error validating name")
+		})
+	}
+}
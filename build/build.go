@@ -0,0 +1,175 @@
+package build
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/pkg/errors"
+)
+
+// sdkVersionPackage is the package containing the version information of Cosmos SDK based binaries.
+const sdkVersionPackage = "github.com/cosmos/cosmos-sdk/version"
+
+// unsafeVersionChars matches characters, that are not allowed in binary names.
+var unsafeVersionChars = regexp.MustCompile(`[^\w.+-]`)
+
+// Options are the options to build a binary from a source checkout.
+type Options struct {
+	// Src is the path of the git repository containing the source code.
+	Src string
+	// Ref is the git reference to build, e.g. a tag, branch or commit.
+	Ref string
+	// Out is the directory the binary is written to.
+	Out string
+	// UseMake defines whether the binary is built with "make build" instead of "go build".
+	UseMake bool
+}
+
+// Result describes a built binary.
+type Result struct {
+	// Path is the path of the built binary.
+	Path string
+	// Version is the version of the built binary, as described by git.
+	Version string
+	// VersionInfo is the output of the binary's "version --long" command.
+	VersionInfo string
+}
+
+// Build checks out the given reference of the source repository in a temporary worktree
+// and builds the binary offline, i.e. only using the local module cache. The binary is named
+// after the configured binary and the version of the reference, e.g. "evmosd-v16.0.3".
+func Build(bin *utils.Binary, opts Options) (Result, error) {
+	if _, err := runCommand(opts.Src, nil, "git", "rev-parse", "--git-dir"); err != nil {
+		return Result{}, fmt.Errorf("%s is not a git repository", opts.Src)
+	}
+
+	worktree, err := os.MkdirTemp("", "evmos-utils-build")
+	if err != nil {
+		return Result{}, errors.Wrap(err, "error creating temporary directory")
+	}
+
+	bin.Logger.Info().Msgf("checking out %s in %s", opts.Ref, worktree)
+
+	worktreeArgs := []string{"worktree", "add", "--detach", "--force", worktree, opts.Ref}
+	if _, err = runCommand(opts.Src, nil, "git", worktreeArgs...); err != nil {
+		return Result{}, errors.Wrapf(err, "error checking out %s", opts.Ref)
+	}
+
+	defer func() {
+		if _, removeErr := runCommand(opts.Src, nil, "git", "worktree", "remove", "--force", worktree); removeErr != nil {
+			bin.Logger.Error().Msgf("error removing worktree %s: %s", worktree, removeErr)
+		}
+	}()
+
+	version, err := runCommand(worktree, nil, "git", "describe", "--tags", "--always")
+	if err != nil {
+		return Result{}, errors.Wrap(err, "error getting version")
+	}
+
+	commit, err := runCommand(worktree, nil, "git", "rev-parse", "HEAD")
+	if err != nil {
+		return Result{}, errors.Wrap(err, "error getting commit")
+	}
+
+	appName := filepath.Base(bin.Config.Appd)
+
+	// NOTE: references of registered binaries, e.g. "evmosd@v16.0.3", are built under the binary's name
+	if utils.IsBinaryRef(bin.Config.Appd) {
+		appName, _, _ = strings.Cut(appName, "@")
+	}
+
+	outDir, err := filepath.Abs(opts.Out)
+	if err != nil {
+		return Result{}, errors.Wrap(err, "error getting output directory")
+	}
+
+	if err = os.MkdirAll(outDir, 0o750); err != nil {
+		return Result{}, errors.Wrap(err, "error creating output directory")
+	}
+
+	outPath := filepath.Join(outDir, GetBinaryName(appName, version))
+
+	bin.Logger.Info().Msgf("building %s %s", appName, version)
+
+	if opts.UseMake {
+		err = buildWithMake(worktree, appName, outPath)
+	} else {
+		err = buildWithGo(worktree, appName, version, commit, outPath)
+	}
+
+	if err != nil {
+		return Result{}, err
+	}
+
+	versionInfo, err := runCommand(worktree, nil, outPath, "version", "--long")
+	if err != nil {
+		return Result{}, errors.Wrap(err, "error getting version of built binary")
+	}
+
+	return Result{Path: outPath, Version: version, VersionInfo: versionInfo}, nil
+}
+
+// GetBinaryName returns the name of the binary for the given application and version.
+func GetBinaryName(appName, version string) string {
+	return fmt.Sprintf("%s-%s", appName, unsafeVersionChars.ReplaceAllString(version, "_"))
+}
+
+// GetLdflags returns the linker flags, that set the version information of a Cosmos SDK based binary.
+func GetLdflags(appName, version, commit string) string {
+	name := strings.TrimSuffix(appName, "d")
+
+	return strings.Join([]string{
+		fmt.Sprintf("-X %s.Name=%s", sdkVersionPackage, name),
+		fmt.Sprintf("-X %s.AppName=%s", sdkVersionPackage, appName),
+		fmt.Sprintf("-X %s.Version=%s", sdkVersionPackage, strings.TrimPrefix(version, "v")),
+		fmt.Sprintf("-X %s.Commit=%s", sdkVersionPackage, commit),
+	}, " ")
+}
+
+// buildWithGo builds the main package of the given application with "go build".
+func buildWithGo(worktree, appName, version, commit, outPath string) error {
+	_, err := runCommand(worktree, offlineEnv(),
+		"go", "build", "-mod=readonly", "-ldflags", GetLdflags(appName, version, commit),
+		"-o", outPath, "./cmd/"+appName,
+	)
+
+	return errors.Wrap(err, "error building binary")
+}
+
+// buildWithMake builds the binary with "make build" and moves it to the given output path.
+func buildWithMake(worktree, appName, outPath string) error {
+	if _, err := runCommand(worktree, offlineEnv(), "make", "build"); err != nil {
+		return errors.Wrap(err, "error building binary")
+	}
+
+	if err := os.Rename(filepath.Join(worktree, "build", appName), outPath); err != nil {
+		return errors.Wrap(err, "error moving built binary")
+	}
+
+	return nil
+}
+
+// offlineEnv returns the environment to build without network access, i.e. only using the local module cache.
+func offlineEnv() []string {
+	return append(os.Environ(), "GOPROXY=off", "GOFLAGS=-mod=readonly")
+}
+
+// runCommand executes the given command in the given directory and returns its trimmed output.
+func runCommand(dir string, env []string, name string, args ...string) (string, error) {
+	//#nosec G204 // only internal commands are executed with arguments explicitly passed by the user
+	cmd := exec.Command(name, args...)
+	cmd.Dir = dir
+	cmd.Env = env
+
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("%s %s failed: %w\n%s", name, strings.Join(args, " "), err, out)
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
@@ -0,0 +1,56 @@
+package build_test
+
+import (
+	"testing"
+
+	"github.com/MalteHerrmann/evmos-utils/build"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBinaryName(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		version string
+		expName string
+	}{
+		{
+			name:    "pass - release tag",
+			version: "v16.0.3",
+			expName: "evmosd-v16.0.3",
+		},
+		{
+			name:    "pass - commits after tag",
+			version: "v17.0.0-rc1-5-g1a2b3c4",
+			expName: "evmosd-v17.0.0-rc1-5-g1a2b3c4",
+		},
+		{
+			name:    "pass - unsafe characters",
+			version: "feature/new upgrade",
+			expName: "evmosd-feature_new_upgrade",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.expName, build.GetBinaryName("evmosd", tc.version), "expected different binary name")
+		})
+	}
+}
+
+func TestGetLdflags(t *testing.T) {
+	t.Parallel()
+
+	ldflags := build.GetLdflags("evmosd", "v16.0.3", "abc123")
+	require.Equal(t,
+		"-X github.com/cosmos/cosmos-sdk/version.Name=evmos "+
+			"-X github.com/cosmos/cosmos-sdk/version.AppName=evmosd "+
+			"-X github.com/cosmos/cosmos-sdk/version.Version=16.0.3 "+
+			"-X github.com/cosmos/cosmos-sdk/version.Commit=abc123",
+		ldflags,
+		"expected different linker flags",
+	)
+}
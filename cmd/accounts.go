@@ -0,0 +1,236 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/MalteHerrmann/evmos-utils/accounts"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// createOptions are the options to create test accounts.
+	createOptions accounts.CreateOptions
+	// exportOptions are the options to export the test accounts.
+	exportOptions accounts.ExportOptions
+	// exportOut is the file the exported accounts are written to. If empty, they are printed.
+	exportOut string
+	// exportUnsafe confirms that the private keys are exported in plain text.
+	exportUnsafe bool
+	// listOutput is the output format of the account states.
+	listOutput string
+	// listRPCURL is the URL of the JSON-RPC server, that is used to query ERC20 balances.
+	listRPCURL string
+)
+
+//nolint:gochecknoglobals // required by cobra
+var accountsCmd = &cobra.Command{
+	Use:   "accounts",
+	Short: "Manage the test accounts in the keyring",
+}
+
+//nolint:gochecknoglobals // required by cobra
+var accountsCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create and fund test accounts",
+	Long: `Create test accounts in the keyring and optionally fund them from the --from key in a single transaction.
+The eth_secp256k1 keys are derived with coin type 60 from the given mnemonic, a mnemonic derived from --seed
+or a random mnemonic. Using the same mnemonic or seed creates the same accounts on every run,
+reusing existing keys with the derived addresses. With --dry-run, the keys are not added to the keyring.`,
+	Example: "evmos-utils accounts create --count 10 --prefix user --fund 100evmos --seed ci",
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		bin, err := utils.NewBinary(collectConfig())
+		if err != nil {
+			return errors.Wrap(err, "error creating binary")
+		}
+
+		result, err := accounts.Create(bin, createOptions)
+		if err != nil {
+			return errors.Wrap(err, "error creating accounts")
+		}
+
+		if createOptions.Mnemonic == "" && createOptions.Seed == "" {
+			bin.Logger.Info().Msgf("created accounts from random mnemonic: %s", result.Mnemonic)
+		}
+
+		writer := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+
+		if _, err = fmt.Fprintln(writer, "NAME\tADDRESS\tHEX ADDRESS"); err != nil {
+			return errors.Wrap(err, "error printing accounts")
+		}
+
+		for _, acc := range result.Accounts {
+			if _, err = fmt.Fprintf(writer, "%s\t%s\t%s\n", acc.Name, acc.Address, acc.HexAddress); err != nil {
+				return errors.Wrap(err, "error printing accounts")
+			}
+		}
+
+		return errors.Wrap(writer.Flush(), "error printing accounts")
+	},
+}
+
+//nolint:gochecknoglobals // required by cobra
+var accountsExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the private keys of test accounts for EVM tooling",
+	Long: `Export the private keys of the selected accounts together with the JSON-RPC URL and the EVM chain ID
+as a configuration snippet for Hardhat, Foundry, a .env file or MetaMask.
+The private keys are written in plain text, so this is only supported for the test keyring backend
+and has to be confirmed with --unsafe.`,
+	Example: "evmos-utils accounts export --format hardhat --unsafe",
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		if !exportUnsafe {
+			return errors.New("exporting private keys in plain text requires the --unsafe flag")
+		}
+
+		bin, err := utils.NewBinary(collectConfig())
+		if err != nil {
+			return errors.Wrap(err, "error creating binary")
+		}
+
+		evmChainID, err := accounts.GetEVMChainID(bin.Config.ChainID)
+		if err != nil {
+			return err
+		}
+
+		keys, err := accounts.ExportKeys(bin)
+		if err != nil {
+			return errors.Wrap(err, "error exporting keys")
+		}
+
+		out, err := accounts.FormatKeys(keys, exportOptions, evmChainID)
+		if err != nil {
+			return err
+		}
+
+		if exportOut == "" {
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), out)
+
+			return errors.Wrap(err, "error printing accounts")
+		}
+
+		if err = os.WriteFile(exportOut, []byte(out+"\n"), 0o600); err != nil {
+			return errors.Wrap(err, "error writing accounts")
+		}
+
+		bin.Logger.Info().Msgf("exported %d accounts to %s", len(keys), exportOut)
+
+		return nil
+	},
+}
+
+//nolint:gochecknoglobals // required by cobra
+var accountsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Show the balances, stake and rewards of the keyring accounts",
+	Long: `Show the bank and ERC20 balances, delegations, unbonding delegations, pending rewards, vesting status
+and EVM nonce of the selected keyring accounts. The ERC20 balances of all enabled token pairs are queried
+through the JSON-RPC server and skipped if --rpc-url is empty. Balances, that could not be queried,
+are shown as "?" followed by the denomination.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		if listOutput != "table" && listOutput != "json" {
+			return fmt.Errorf("invalid output format: %s; please use table or json", listOutput)
+		}
+
+		bin, err := utils.NewBinary(collectConfig())
+		if err != nil {
+			return errors.Wrap(err, "error creating binary")
+		}
+
+		states, err := accounts.GetStates(bin, listRPCURL)
+		if err != nil {
+			return errors.Wrap(err, "error querying accounts")
+		}
+
+		if listOutput == "json" {
+			var bz []byte
+			if bz, err = json.MarshalIndent(states, "", "  "); err != nil {
+				return errors.Wrap(err, "error marshalling accounts")
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+
+			return errors.Wrap(err, "error printing accounts")
+		}
+
+		return printAccountStates(cmd.OutOrStdout(), states, bin.Config.Denom)
+	},
+}
+
+// printAccountStates prints the given account states as a table.
+func printAccountStates(out io.Writer, states []accounts.State, bondDenom string) error {
+	writer := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+
+	header := "NAME\tADDRESS\tBALANCES\tERC20\tDELEGATED\tUNBONDING\tREWARDS\tVESTING\tNONCE"
+	if _, err := fmt.Fprintln(writer, header); err != nil {
+		return errors.Wrap(err, "error printing accounts")
+	}
+
+	for _, state := range states {
+		erc20Balances := make([]string, 0, len(state.ERC20Balances))
+		for _, balance := range state.ERC20Balances {
+			erc20Balances = append(erc20Balances, balance.String())
+		}
+
+		if _, err := fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%d\n",
+			state.Name,
+			state.Address,
+			orNone(state.Balances.String()),
+			orNone(strings.Join(erc20Balances, ",")),
+			orNone(state.GetDelegated().String()),
+			orNone(state.GetUnbonding(bondDenom).String()),
+			orNone(state.Rewards.String()),
+			orNone(state.VestingType),
+			state.Nonce,
+		); err != nil {
+			return errors.Wrap(err, "error printing accounts")
+		}
+	}
+
+	return errors.Wrap(writer.Flush(), "error printing accounts")
+}
+
+// orNone returns the given value or "-" if it is empty.
+func orNone(value string) string {
+	if value == "" {
+		return "-"
+	}
+
+	return value
+}
+
+//nolint:gochecknoinits // required by cobra
+func init() {
+	accountsCreateCmd.Flags().IntVar(&createOptions.Count, "count", 1, "Number of accounts to create")
+	accountsCreateCmd.Flags().StringVar(&createOptions.Prefix, "prefix", "user", "Prefix of the account names")
+	accountsCreateCmd.Flags().StringVar(&createOptions.Fund, "fund", "", "Amount to fund each account with, e.g. 100evmos")
+	accountsCreateCmd.Flags().StringVar(&createOptions.Mnemonic, "mnemonic", "", "Mnemonic to derive the accounts from")
+	accountsCreateCmd.Flags().StringVar(&createOptions.Seed, "seed", "", "Seed to derive a deterministic mnemonic from")
+
+	accountsExportCmd.Flags().StringVar(
+		&exportOptions.Format, "format", "hardhat", "Format to export in ("+strings.Join(accounts.ExportFormats, "|")+")",
+	)
+	accountsExportCmd.Flags().StringVar(
+		&exportOptions.RPCURL, "rpc-url", "http://localhost:8545", "URL of the JSON-RPC server of the node",
+	)
+	accountsExportCmd.Flags().StringVar(&exportOut, "out", "", "File to write the export to; printed if empty")
+	accountsExportCmd.Flags().BoolVar(&exportUnsafe, "unsafe", false, "Confirm exporting private keys in plain text")
+
+	accountsListCmd.Flags().StringVarP(&listOutput, "output", "o", "table", "Output format (table|json)")
+	accountsListCmd.Flags().StringVar(
+		&listRPCURL, "rpc-url", "http://localhost:8545", "URL of the JSON-RPC server to query ERC20 balances",
+	)
+
+	accountsCmd.AddCommand(accountsCreateCmd)
+	accountsCmd.AddCommand(accountsExportCmd)
+	accountsCmd.AddCommand(accountsListCmd)
+}
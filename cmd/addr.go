@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// addrPrefix is the bech32 prefix of the converted account address.
+	addrPrefix string
+	// addrOutput is the output format of the converted addresses.
+	addrOutput string
+)
+
+//nolint:gochecknoglobals // required by cobra
+var addrCmd = &cobra.Command{
+	Use:   "addr ADDRESS_OR_KEY",
+	Short: "Convert an address between the bech32, hex and validator formats",
+	Long: `Convert a bech32 address with any prefix, a hex address or the address of a key
+in the keyring to the bech32 account address, the hex address and the validator operator address.`,
+	Example: "evmos-utils addr 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if addrOutput != "text" && addrOutput != "json" {
+			return fmt.Errorf("invalid output format: %s; please use text or json", addrOutput)
+		}
+
+		bz, err := utils.ParseAddress(args[0])
+		if err != nil {
+			// NOTE: the keyring is only loaded if the argument is not an address
+			if bz, err = getKeyAddress(args[0]); err != nil {
+				return err
+			}
+		}
+
+		addresses, err := utils.GetAddresses(bz, addrPrefix)
+		if err != nil {
+			return err
+		}
+
+		out := addresses.String()
+		if addrOutput == "json" {
+			if out, err = addresses.JSON(); err != nil {
+				return err
+			}
+		}
+
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), out)
+
+		return errors.Wrap(err, "error printing addresses")
+	},
+}
+
+// getKeyAddress returns the address bytes of the key with the given name in the keyring.
+func getKeyAddress(name string) ([]byte, error) {
+	bin, err := utils.NewBinary(collectConfig())
+	if err != nil {
+		return nil, errors.Wrapf(err, "%q is not a valid address and the keyring could not be loaded", name)
+	}
+
+	acc, err := utils.FindAccount(bin.Accounts, name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "%q is neither a valid address nor a key", name)
+	}
+
+	return acc.Bytes()
+}
+
+//nolint:gochecknoinits // required by cobra
+func init() {
+	addrCmd.Flags().StringVar(&addrPrefix, "prefix", utils.Bech32Prefix, "Bech32 prefix of the account address")
+	addrCmd.Flags().StringVarP(&addrOutput, "output", "o", "text", "Output format of the addresses (text|json)")
+}
@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"github.com/MalteHerrmann/evmos-utils/gov"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// authzGrantee is the key or address, that is granted the authorization.
+	authzGrantee string
+)
+
+//nolint:gochecknoglobals // required by cobra
+var authzCmd = &cobra.Command{
+	Use:   "authz",
+	Short: "Manage authz grants of the keyring accounts",
+}
+
+//nolint:gochecknoglobals // required by cobra
+var authzGrantVoteCmd = &cobra.Command{
+	Use:   "grant-vote",
+	Short: "Grant a key the authorization to vote on behalf of all keys with delegations",
+	Long: `Grant the --grantee the authorization to vote on behalf of all selected keys, that have delegations.
+Afterwards, the grantee can vote for these keys with vote --via-authz.`,
+	Example: "evmos-utils authz grant-vote --grantee hot --keys \"val*\"",
+	Args:    cobra.NoArgs,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		if authzGrantee == "" {
+			return errors.New("the grantee must be set with --grantee")
+		}
+
+		bin, err := utils.NewBinary(collectConfig())
+		if err != nil {
+			return errors.Wrap(err, "error creating binary")
+		}
+
+		return gov.GrantVotes(bin, authzGrantee)
+	},
+}
+
+//nolint:gochecknoinits // required by cobra
+func init() {
+	authzGrantVoteCmd.Flags().StringVar(&authzGrantee, "grantee", "", "Key name or address of the grantee")
+
+	authzCmd.AddCommand(authzGrantVoteCmd)
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/MalteHerrmann/evmos-utils/build"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// buildOptions are the options to build the binary from a source checkout.
+var buildOptions build.Options
+
+//nolint:gochecknoglobals // required by cobra
+var buildCmd = &cobra.Command{
+	Use:   "build",
+	Short: "Build the binary from a local source checkout",
+	Long: `Build the binary from the given git reference of a local source checkout, e.g. to test
+an unreleased upgrade. The reference is checked out in a temporary worktree, so that the checkout
+itself is not modified. The binary is built offline using the local module cache and is named
+after the configured binary and the version, e.g. "evmosd-v16.0.3".
+
+The built binary can be used with --bin or as the binary of a step in an upgrade sequence.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		if buildOptions.Src == "" || buildOptions.Ref == "" {
+			return errors.New("the source path and the reference are required")
+		}
+
+		config := collectConfig()
+
+		// NOTE: the configured binary only determines the name of the built binary, so it does not have to exist
+		bin := &utils.Binary{Config: config, Logger: utils.NewLogger()}
+
+		result, err := build.Build(bin, buildOptions)
+		if err != nil {
+			return errors.Wrap(err, "error building binary")
+		}
+
+		bin.Logger.Info().Msgf("built %s", result.Path)
+
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), result.VersionInfo)
+
+		return errors.Wrap(err, "error printing version")
+	},
+}
+
+//nolint:gochecknoinits // required by cobra
+func init() {
+	buildCmd.Flags().StringVar(&buildOptions.Src, "src", "", "Path of the git repository containing the source code")
+	buildCmd.Flags().StringVar(&buildOptions.Ref, "ref", "", "Git reference to build, e.g. a tag, branch or commit")
+	buildCmd.Flags().StringVar(&buildOptions.Out, "out", "build", "Directory to write the binary to")
+	buildCmd.Flags().BoolVar(&buildOptions.UseMake, "make", false, "Build with \"make build\" instead of \"go build\"")
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"github.com/MalteHerrmann/evmos-utils/testnet"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// haltOptions are the options for the coordinated halt.
+var haltOptions testnet.HaltOptions
+
+//nolint:gochecknoglobals // required by cobra
+var emergencyHaltCmd = &cobra.Command{
+	Use:   "emergency-halt",
+	Short: "Perform a coordinated halt of the local testnet",
+	Long: `Rehearse a coordinated halt of the local testnet in the configured home directory,
+as it is used for emergency upgrades without governance.
+
+This sets the halt height in the app.toml of every node, restarts the nodes and waits for all of them
+to stop at the halt height. Afterwards, the halt height is cleared and the nodes are restarted
+with the new binary, which is passed with --new-bin. Finally, it is confirmed that the chain
+continues producing blocks.`,
+	Args: cobra.NoArgs,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		if haltOptions.Height <= 0 {
+			return errors.New("a positive halt height is required")
+		}
+
+		bin, err := utils.NewUninitializedBinary(collectConfig())
+		if err != nil {
+			return errors.Wrap(err, "error creating binary")
+		}
+
+		network, err := testnet.Load(bin)
+		if err != nil {
+			return err
+		}
+
+		if err = testnet.EmergencyHalt(bin, network, haltOptions); err != nil {
+			return errors.Wrap(err, "error performing emergency halt")
+		}
+
+		bin.Logger.Info().Msgf("testnet halted at height %d and continued with %s", haltOptions.Height, bin.Config.Appd)
+
+		return nil
+	},
+}
+
+//nolint:gochecknoinits // required by cobra
+func init() {
+	emergencyHaltCmd.Flags().IntVar(&haltOptions.Height, "height", 0, "Height after which all nodes halt")
+	emergencyHaltCmd.Flags().StringVar(
+		&haltOptions.NewBinary, "new-bin", "", "Binary to restart the nodes with after the halt (default: --bin)",
+	)
+	emergencyHaltCmd.Flags().IntVar(
+		&haltOptions.Blocks, "blocks", 5, "Number of blocks that have to be produced after the restart",
+	)
+}
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"github.com/MalteHerrmann/evmos-utils/feegrant"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// feegrantGranter is the key, that grants the fee allowances.
+	feegrantGranter string
+	// feegrantSpendLimit is the maximum amount of fees, that each grantee can spend.
+	feegrantSpendLimit string
+)
+
+//nolint:gochecknoglobals // required by cobra
+var feegrantCmd = &cobra.Command{
+	Use:   "feegrant",
+	Short: "Manage fee allowances of the keyring accounts",
+}
+
+//nolint:gochecknoglobals // required by cobra
+var feegrantSetupCmd = &cobra.Command{
+	Use:   "setup",
+	Short: "Grant fee allowances from a funded key to all selected keys",
+	Long: `Grant a fee allowance from the --granter to all selected keys, so that their transactions
+can be paid by the granter when passing it with --fee-granter, e.g. to vote with delegators
+without liquid balance. Keys, that already have an allowance from the granter, are skipped.`,
+	Example: "evmos-utils feegrant setup --granter dev0 --spend-limit 1evmos",
+	Args:    cobra.NoArgs,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		if feegrantGranter == "" {
+			return errors.New("the granter must be set with --granter")
+		}
+
+		bin, err := utils.NewBinary(collectConfig())
+		if err != nil {
+			return errors.Wrap(err, "error creating binary")
+		}
+
+		return feegrant.Setup(bin, feegrantGranter, feegrantSpendLimit)
+	},
+}
+
+//nolint:gochecknoinits // required by cobra
+func init() {
+	feegrantSetupCmd.Flags().StringVar(&feegrantGranter, "granter", "", "Key name or address of the granter")
+	feegrantSetupCmd.Flags().StringVar(
+		&feegrantSpendLimit, "spend-limit", "", "Maximum fees each key can spend (e.g. 1evmos); unlimited if empty",
+	)
+
+	feegrantCmd.AddCommand(feegrantSetupCmd)
+}
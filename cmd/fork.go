@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"time"
+
+	"github.com/MalteHerrmann/evmos-utils/fork"
+	"github.com/MalteHerrmann/evmos-utils/upgrade"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// forkOptions are the options to create the local fork.
+	forkOptions fork.Options
+	// forkUpgrade is the target version of an upgrade to prepare on the fork.
+	forkUpgrade string
+)
+
+//nolint:gochecknoglobals // required by cobra
+var forkCmd = &cobra.Command{
+	Use:   "fork",
+	Short: "Start a local single-validator fork from exported chain state",
+	Long: `Create a local node in the configured home directory from exported chain state,
+e.g. to rehearse upgrades on mainnet-like state. The state is either read from a genesis file
+created with "evmosd export" or exported from the home directory of a stopped node.
+
+All accounts and their liquid balances are kept, while the validator set is replaced by a single validator
+using a local test key and the governance periods are shortened. Note that delegated and unbonding tokens
+as well as pending rewards are burned, because the delegations are removed together with the validators.
+Afterwards, the fork is started and the upgrade flow can be used as with any local node.
+If a target version is passed with --upgrade, the upgrade is prepared right away.`,
+	Args: cobra.NoArgs,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		bin, err := utils.NewUninitializedBinary(collectConfig())
+		if err != nil {
+			return errors.Wrap(err, "error creating binary")
+		}
+
+		if forkUpgrade != "" && !forkOptions.Start {
+			return errors.New("the fork has to be started to prepare an upgrade")
+		}
+
+		if err = fork.Create(bin, forkOptions); err != nil {
+			return errors.Wrap(err, "error creating fork")
+		}
+
+		bin.Logger.Info().Msgf("successfully created fork in %s", bin.Config.Home)
+
+		if forkUpgrade == "" {
+			return nil
+		}
+
+		if bin, err = utils.NewBinary(collectConfig()); err != nil {
+			return errors.Wrap(err, "error creating binary")
+		}
+
+		if _, err = upgrade.Prepare(bin, forkUpgrade, false); err != nil {
+			return errors.Wrap(err, "error preparing upgrade on fork")
+		}
+
+		bin.Logger.Info().Msgf("successfully prepared upgrade to %s", forkUpgrade)
+
+		return nil
+	},
+}
+
+//nolint:gochecknoinits // required by cobra
+func init() {
+	forkCmd.Flags().StringVar(&forkOptions.GenesisFile, "genesis", "", "Exported genesis file to fork from")
+	forkCmd.Flags().StringVar(&forkOptions.ExportHome, "export-home", "", "Home directory of a stopped node to export")
+	forkCmd.Flags().IntVar(&forkOptions.ExportHeight, "export-height", 0, "Height to export (default: latest height)")
+	forkCmd.Flags().StringVar(&forkUpgrade, "upgrade", "", "Target version of an upgrade to prepare on the fork")
+	forkCmd.Flags().StringVar(&forkOptions.Moniker, "moniker", "localfork", "Moniker of the local validator")
+	forkCmd.Flags().IntVar(&forkOptions.NKeys, "num-keys", 3, "Number of test keys to create and fund")
+	forkCmd.Flags().StringVar(
+		&forkOptions.Balance, "balance", "100000000000000000000000000", "Amount to fund every test key with",
+	)
+	forkCmd.Flags().StringVar(
+		&forkOptions.SelfDelegation, "self-delegation", "1000000000000000000000", "Self-delegation of the validator",
+	)
+	forkCmd.Flags().StringVar(
+		&forkOptions.MinDeposit, "min-deposit", "10000000", "Minimum deposit for governance proposals",
+	)
+	forkCmd.Flags().DurationVar(
+		&forkOptions.VotingPeriod, "voting-period", 30*time.Second, "Voting period of governance proposals",
+	)
+	forkCmd.Flags().BoolVar(&forkOptions.Overwrite, "overwrite", false, "Remove an existing home directory")
+	forkCmd.Flags().BoolVar(&forkOptions.Start, "start", true, "Start the fork in the background")
+}
@@ -0,0 +1,203 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MalteHerrmann/evmos-utils/genesis"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// skipGenesisValidation defines whether the validation of the edited genesis is skipped.
+	skipGenesisValidation bool
+)
+
+//nolint:gochecknoglobals // required by cobra
+var genesisCmd = &cobra.Command{
+	Use:   "genesis",
+	Short: "Edit the genesis file of the local node",
+	Long: `Inspect and edit the genesis file in the configured home directory, e.g. to tune a devnet
+before it is started. Edited genesis files are validated against the Evmos modules.`,
+}
+
+//nolint:gochecknoglobals // required by cobra
+var genesisGetCmd = &cobra.Command{
+	Use:   "get PATH",
+	Short: "Print a field of the genesis file",
+	Long: `Print the field of the genesis file at the given path.
+Module fields can be addressed relative to the application state and module parameters
+without the "params" element, e.g. "gov.voting_period".`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		genesisState, _, err := loadGenesis()
+		if err != nil {
+			return err
+		}
+
+		path, err := genesisState.ResolvePath(args[0])
+		if err != nil {
+			return err
+		}
+
+		value, err := genesisState.Get(path)
+		if err != nil {
+			return err
+		}
+
+		bz, err := json.MarshalIndent(value, "", "  ")
+		if err != nil {
+			return errors.Wrap(err, "error marshalling value")
+		}
+
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+
+		return errors.Wrap(err, "error printing value")
+	},
+}
+
+//nolint:gochecknoglobals // required by cobra
+var genesisSetCmd = &cobra.Command{
+	Use:   "set PATH VALUE",
+	Short: "Set a field of the genesis file",
+	Long: `Set the field of the genesis file at the given path.
+The value is parsed according to the type of the existing field,
+e.g. durations can be given as "30s" or "5m" and coins as "1000aevmos".
+
+Examples:
+  genesis set gov.voting_period 30s
+  genesis set evm.params.allow_unprotected_txs true
+  genesis set gov.min_deposit 1000000aevmos`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(_ *cobra.Command, args []string) error {
+		genesisState, bin, err := loadGenesis()
+		if err != nil {
+			return err
+		}
+
+		path, err := genesisState.SetTyped(args[0], args[1])
+		if err != nil {
+			return err
+		}
+
+		if !skipGenesisValidation {
+			if err = genesisState.Validate(bin.Cdc); err != nil {
+				return err
+			}
+		}
+
+		if err = genesisState.Save(genesis.GetGenesisPath(bin.Config.Home)); err != nil {
+			return err
+		}
+
+		bin.Logger.Info().Msgf("set %s to %s", path, args[1])
+
+		return nil
+	},
+}
+
+//nolint:gochecknoglobals // required by cobra
+var genesisAddAccountCmd = &cobra.Command{
+	Use:   "add-account KEY AMOUNT",
+	Short: "Add a funded account to the genesis file",
+	Long: `Add a genesis account for the given key or address with the given amount.
+If the amount is given without a denomination, the configured denomination is used.`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(_ *cobra.Command, args []string) error {
+		genesisState, bin, err := loadGenesis()
+		if err != nil {
+			return err
+		}
+
+		address, err := getGenesisAccountAddress(bin, args[0])
+		if err != nil {
+			return err
+		}
+
+		if err = genesisState.AddAccount(address, args[1], bin.Config.Denom); err != nil {
+			return err
+		}
+
+		if !skipGenesisValidation {
+			if err = genesisState.Validate(bin.Cdc); err != nil {
+				return err
+			}
+		}
+
+		if err = genesisState.Save(genesis.GetGenesisPath(bin.Config.Home)); err != nil {
+			return err
+		}
+
+		bin.Logger.Info().Msgf("added genesis account %s with %s", args[0], args[1])
+
+		return nil
+	},
+}
+
+//nolint:gochecknoglobals // required by cobra
+var genesisValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Validate the genesis file",
+	Long:  "Validate the genesis file in the configured home directory against the Evmos modules.",
+	Args:  cobra.NoArgs,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		genesisState, bin, err := loadGenesis()
+		if err != nil {
+			return err
+		}
+
+		if err = genesisState.Validate(bin.Cdc); err != nil {
+			return err
+		}
+
+		bin.Logger.Info().Msg("genesis is valid")
+
+		return nil
+	},
+}
+
+// loadGenesis loads the genesis file from the configured home directory.
+func loadGenesis() (genesis.Genesis, *utils.Binary, error) {
+	bin, err := utils.NewUninitializedBinary(collectConfig())
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "error creating binary")
+	}
+
+	genesisState, err := genesis.Load(genesis.GetGenesisPath(bin.Config.Home))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return genesisState, bin, nil
+}
+
+// getGenesisAccountAddress returns the bech32 address of the given key or address.
+// The keyring is only loaded if no address is given.
+func getGenesisAccountAddress(bin *utils.Binary, keyOrAddress string) (string, error) {
+	if addresses, err := utils.ConvertAddress(keyOrAddress, utils.Bech32Prefix); err == nil {
+		return addresses.Bech32, nil
+	}
+
+	accounts, err := utils.ListAccounts(bin, bin.Config.Home)
+	if err != nil {
+		return "", errors.Wrapf(err, "%q is not a valid address and the keyring could not be loaded", keyOrAddress)
+	}
+
+	bin.Accounts = accounts
+
+	return bin.ResolveAddress(keyOrAddress)
+}
+
+//nolint:gochecknoinits // required by cobra
+func init() {
+	for _, command := range []*cobra.Command{genesisSetCmd, genesisAddAccountCmd} {
+		command.Flags().BoolVar(&skipGenesisValidation, "skip-validation", false, "Skip validating the edited genesis")
+	}
+
+	genesisCmd.AddCommand(genesisGetCmd)
+	genesisCmd.AddCommand(genesisSetCmd)
+	genesisCmd.AddCommand(genesisAddAccountCmd)
+	genesisCmd.AddCommand(genesisValidateCmd)
+}
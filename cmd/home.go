@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"github.com/MalteHerrmann/evmos-utils/backup"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// forceRestore defines whether a snapshot is restored even if the binary version differs.
+var forceRestore bool
+
+//nolint:gochecknoglobals // required by cobra
+var homeCmd = &cobra.Command{
+	Use:   "home",
+	Short: "Manage the home directory of the local node",
+}
+
+//nolint:gochecknoglobals // required by cobra
+var homeSnapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Save and restore snapshots of the home directory",
+	Long: `Save and restore snapshots of the home directory including the data, configuration and keyring,
+e.g. to repeat an upgrade rehearsal from the same state. The snapshots are stored as compressed
+tarballs next to the home directory.`,
+}
+
+//nolint:gochecknoglobals // required by cobra
+var homeSnapshotSaveCmd = &cobra.Command{
+	Use:   "save NAME",
+	Short: "Save a snapshot of the home directory",
+	Long: `Save a snapshot of the home directory with the given name. The latest block height and the binary
+version are recorded with the snapshot. A running node is stopped while the snapshot is saved
+and started again afterwards.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		bin, err := utils.NewUninitializedBinary(collectConfig())
+		if err != nil {
+			return errors.Wrap(err, "error creating binary")
+		}
+
+		metadata, err := backup.SaveSnapshot(bin, args[0])
+		if err != nil {
+			return errors.Wrap(err, "error saving snapshot")
+		}
+
+		bin.Logger.Info().Msgf(
+			"saved snapshot %s at height %d with version %s", metadata.Name, metadata.Height, metadata.BinaryVersion,
+		)
+
+		return nil
+	},
+}
+
+//nolint:gochecknoglobals // required by cobra
+var homeSnapshotRestoreCmd = &cobra.Command{
+	Use:   "restore NAME",
+	Short: "Restore the home directory from a snapshot",
+	Long: `Replace the home directory with the snapshot with the given name. If the snapshot was taken
+with a different binary version than the configured binary, it is only restored with --force.
+A running node is stopped before restoring the snapshot and started again afterwards.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		bin, err := utils.NewUninitializedBinary(collectConfig())
+		if err != nil {
+			return errors.Wrap(err, "error creating binary")
+		}
+
+		metadata, err := backup.RestoreSnapshot(bin, args[0], forceRestore)
+		if err != nil {
+			return errors.Wrap(err, "error restoring snapshot")
+		}
+
+		bin.Logger.Info().Msgf("restored snapshot %s from height %d", metadata.Name, metadata.Height)
+
+		return nil
+	},
+}
+
+//nolint:gochecknoinits // required by cobra
+func init() {
+	homeSnapshotRestoreCmd.Flags().BoolVar(&forceRestore, "force", false, "Restore even if the binary version differs")
+
+	homeSnapshotCmd.AddCommand(homeSnapshotSaveCmd)
+	homeSnapshotCmd.AddCommand(homeSnapshotRestoreCmd)
+	homeCmd.AddCommand(homeSnapshotCmd)
+}
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"time"
+
+	localnode "github.com/MalteHerrmann/evmos-utils/node"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// initOptions are the options to bootstrap the local node.
+var initOptions localnode.InitOptions
+
+//nolint:gochecknoglobals // required by cobra
+var initNodeCmd = &cobra.Command{
+	Use:   "init-node",
+	Short: "Initialize and start a local node",
+	Long: `Initialize a local node in the configured home directory, that can be used with the other commands.
+This creates the genesis, creates and funds test keys, shortens the governance voting period,
+lowers the minimum deposit and creates a validator using the first test key.
+Afterwards, the node is started in the background.
+
+All commands are executed using the configured binary, chain ID, denomination and keyring backend.`,
+	Args: cobra.NoArgs,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		bin, err := utils.NewUninitializedBinary(collectConfig())
+		if err != nil {
+			return errors.Wrap(err, "error creating binary")
+		}
+
+		if err = localnode.Init(bin, initOptions); err != nil {
+			return errors.Wrap(err, "error initializing node")
+		}
+
+		bin.Logger.Info().Msgf("successfully initialized node in %s", bin.Config.Home)
+
+		return nil
+	},
+}
+
+//nolint:gochecknoinits // required by cobra
+func init() {
+	initNodeCmd.Flags().StringVar(&initOptions.Moniker, "moniker", "localtestnet", "Moniker of the local validator")
+	initNodeCmd.Flags().IntVar(&initOptions.NKeys, "num-keys", 3, "Number of test keys to create and fund")
+	initNodeCmd.Flags().StringVar(
+		&initOptions.Balance, "balance", "100000000000000000000000000", "Amount to fund every test key with",
+	)
+	initNodeCmd.Flags().StringVar(
+		&initOptions.SelfDelegation, "self-delegation", "1000000000000000000000", "Self-delegation of the validator",
+	)
+	initNodeCmd.Flags().StringVar(
+		&initOptions.MinDeposit, "min-deposit", "10000000", "Minimum deposit for governance proposals",
+	)
+	initNodeCmd.Flags().DurationVar(
+		&initOptions.VotingPeriod, "voting-period", 30*time.Second, "Voting period of governance proposals",
+	)
+	initNodeCmd.Flags().BoolVar(&initOptions.Overwrite, "overwrite", false, "Remove an existing home directory")
+	initNodeCmd.Flags().BoolVar(&initOptions.Start, "start", true, "Start the node in the background")
+}
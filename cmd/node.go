@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	localnode "github.com/MalteHerrmann/evmos-utils/node"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// rpcTimeout is the maximum time to wait for the RPC endpoint after starting the node.
+const rpcTimeout = time.Minute
+
+var (
+	// nodeFollowLogs defines whether new log output is streamed.
+	nodeFollowLogs bool
+	// nodeLogLines is the number of log lines to print.
+	nodeLogLines int
+	// nodeStatusOutput is the output format of the node status.
+	nodeStatusOutput string
+)
+
+//nolint:gochecknoglobals // required by cobra
+var nodeCmd = &cobra.Command{
+	Use:   "node",
+	Short: "Manage the local node process",
+	Long: `Start, stop and inspect the local node. The configured binary is run with the configured
+home directory as a background process, whose PID and logs are stored in the home directory.`,
+}
+
+//nolint:gochecknoglobals // required by cobra
+var nodeStartCmd = &cobra.Command{
+	Use:   "start",
+	Short: "Start the local node in the background",
+	Args:  cobra.NoArgs,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		bin, err := utils.NewUninitializedBinary(collectConfig())
+		if err != nil {
+			return errors.Wrap(err, "error creating binary")
+		}
+
+		return startNode(bin)
+	},
+}
+
+//nolint:gochecknoglobals // required by cobra
+var nodeStopCmd = &cobra.Command{
+	Use:   "stop",
+	Short: "Stop the local node",
+	Args:  cobra.NoArgs,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		bin, err := utils.NewUninitializedBinary(collectConfig())
+		if err != nil {
+			return errors.Wrap(err, "error creating binary")
+		}
+
+		if err = localnode.Stop(bin); err != nil {
+			return errors.Wrap(err, "error stopping node")
+		}
+
+		bin.Logger.Info().Msg("stopped node")
+
+		return nil
+	},
+}
+
+//nolint:gochecknoglobals // required by cobra
+var nodeRestartCmd = &cobra.Command{
+	Use:   "restart",
+	Short: "Restart the local node",
+	Long:  "Stop the local node if it is running and start it again in the background.",
+	Args:  cobra.NoArgs,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		bin, err := utils.NewUninitializedBinary(collectConfig())
+		if err != nil {
+			return errors.Wrap(err, "error creating binary")
+		}
+
+		if _, running := localnode.IsRunning(bin); running {
+			if err = localnode.Stop(bin); err != nil {
+				return errors.Wrap(err, "error stopping node")
+			}
+		}
+
+		return startNode(bin)
+	},
+}
+
+//nolint:gochecknoglobals // required by cobra
+var nodeStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the status of the local node",
+	Long: `Show whether the node process is running, whether its RPC endpoint is reachable
+and the latest block height.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		if nodeStatusOutput != "text" && nodeStatusOutput != "json" {
+			return fmt.Errorf("invalid output format: %s; please use text or json", nodeStatusOutput)
+		}
+
+		bin, err := utils.NewUninitializedBinary(collectConfig())
+		if err != nil {
+			return errors.Wrap(err, "error creating binary")
+		}
+
+		status := localnode.GetStatus(bin)
+
+		out := status.String()
+		if nodeStatusOutput == "json" {
+			if out, err = status.JSON(); err != nil {
+				return err
+			}
+		}
+
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), out)
+
+		return errors.Wrap(err, "error printing status")
+	},
+}
+
+//nolint:gochecknoglobals // required by cobra
+var nodeLogsCmd = &cobra.Command{
+	Use:   "logs",
+	Short: "Print the logs of the local node",
+	Long:  "Print the last lines of the node logs and optionally stream new output until interrupted.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		bin, err := utils.NewUninitializedBinary(collectConfig())
+		if err != nil {
+			return errors.Wrap(err, "error creating binary")
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		return localnode.PrintLogs(ctx, cmd.OutOrStdout(), localnode.GetLogFilePath(bin), nodeLogLines, nodeFollowLogs)
+	},
+}
+
+// startNode starts the local node in the background and waits until its RPC endpoint is reachable.
+func startNode(bin *utils.Binary) error {
+	pid, err := localnode.Start(bin)
+	if err != nil {
+		return errors.Wrap(err, "error starting node")
+	}
+
+	bin.Logger.Info().Msgf("started node with PID %d; logs are written to %s", pid, localnode.GetLogFilePath(bin))
+
+	if err = localnode.WaitForRPC(bin, rpcTimeout); err != nil {
+		return err
+	}
+
+	height, err := utils.GetCurrentHeight(bin)
+	if err != nil {
+		return err
+	}
+
+	bin.Logger.Info().Msgf("node is reachable at %s with height %d", bin.Config.Node, height)
+
+	return nil
+}
+
+//nolint:gochecknoinits // required by cobra
+func init() {
+	nodeStatusCmd.Flags().StringVarP(&nodeStatusOutput, "output", "o", "text", "Output format of the status (text|json)")
+	nodeLogsCmd.Flags().IntVarP(&nodeLogLines, "lines", "n", 50, "Number of lines to print (0 prints all lines)")
+	nodeLogsCmd.Flags().BoolVarP(&nodeFollowLogs, "follow", "f", false, "Stream new log output")
+
+	nodeCmd.AddCommand(nodeStartCmd)
+	nodeCmd.AddCommand(nodeStopCmd)
+	nodeCmd.AddCommand(nodeRestartCmd)
+	nodeCmd.AddCommand(nodeStatusCmd)
+	nodeCmd.AddCommand(nodeLogsCmd)
+}
@@ -0,0 +1,143 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	localnode "github.com/MalteHerrmann/evmos-utils/node"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// configFile is the configuration file to read or edit.
+	configFile string
+	// configPreset is the name of the preset to apply.
+	configPreset string
+)
+
+//nolint:gochecknoglobals // required by cobra
+var nodeConfigCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Read and edit the node configuration",
+	Long: fmt.Sprintf(`Read and edit the settings in %s and %s of the configured home directory.
+Keys are given as "section.key" or just "key" for top-level entries, e.g. "json-rpc.api".
+The configuration file is detected from the key, unless it is selected with --file.
+Comments and formatting of the files are preserved.`, localnode.AppConfigFile, localnode.CometConfigFile),
+}
+
+//nolint:gochecknoglobals // required by cobra
+var nodeConfigGetCmd = &cobra.Command{
+	Use:   "get KEY",
+	Short: "Print a setting of the node configuration",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		bin, err := utils.NewUninitializedBinary(collectConfig())
+		if err != nil {
+			return errors.Wrap(err, "error creating binary")
+		}
+
+		file, err := getConfigFile(bin, args[0])
+		if err != nil {
+			return err
+		}
+
+		value, err := localnode.GetConfigValue(localnode.GetConfigPath(bin.Config.Home, file), args[0])
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), value)
+
+		return errors.Wrap(err, "error printing value")
+	},
+}
+
+//nolint:gochecknoglobals // required by cobra
+var nodeConfigSetCmd = &cobra.Command{
+	Use:   "set [KEY VALUE]",
+	Short: "Edit a setting of the node configuration",
+	Long: fmt.Sprintf(`Set the given key to the given value or apply a preset of settings.
+Values of known keys, e.g. "pruning" or "consensus.timeout_commit", are validated.
+The node has to be restarted for the changes to take effect.
+
+Available presets: %s
+
+Examples:
+  node config set consensus.timeout_commit 1s
+  node config set json-rpc.api eth,net,web3
+  node config set --preset fast-blocks`, strings.Join(localnode.GetPresetNames(), ", ")),
+	Args: func(_ *cobra.Command, args []string) error {
+		if configPreset != "" && len(args) > 0 {
+			return errors.New("either a key and a value or a preset can be given")
+		}
+
+		if configPreset == "" && len(args) != 2 {
+			return errors.New("a key and a value are required")
+		}
+
+		return nil
+	},
+	RunE: func(_ *cobra.Command, args []string) error {
+		bin, err := utils.NewUninitializedBinary(collectConfig())
+		if err != nil {
+			return errors.Wrap(err, "error creating binary")
+		}
+
+		var settings []localnode.Setting
+
+		if configPreset != "" {
+			var found bool
+			if settings, found = localnode.Presets[configPreset]; !found {
+				return fmt.Errorf(
+					"unknown preset %q; available presets: %s", configPreset, strings.Join(localnode.GetPresetNames(), ", "),
+				)
+			}
+		} else {
+			file, fileErr := getConfigFile(bin, args[0])
+			if fileErr != nil {
+				return fileErr
+			}
+
+			settings = []localnode.Setting{{File: file, Key: args[0], Value: args[1]}}
+		}
+
+		for _, setting := range settings {
+			if err = localnode.ApplySetting(bin.Config.Home, setting); err != nil {
+				return err
+			}
+
+			bin.Logger.Info().Msgf("set %s in %s to %s", setting.Key, setting.File, setting.Value)
+		}
+
+		return nil
+	},
+}
+
+// getConfigFile returns the configuration file selected with --file or the one containing the given key.
+func getConfigFile(bin *utils.Binary, key string) (string, error) {
+	switch configFile {
+	case "":
+		return localnode.ResolveConfigFile(bin.Config.Home, key)
+	case localnode.AppConfigFile, localnode.CometConfigFile:
+		return configFile, nil
+	default:
+		return "", fmt.Errorf(
+			"invalid configuration file %q; please use %s or %s", configFile, localnode.AppConfigFile, localnode.CometConfigFile,
+		)
+	}
+}
+
+//nolint:gochecknoinits // required by cobra
+func init() {
+	nodeConfigCmd.PersistentFlags().StringVar(
+		&configFile, "file", "",
+		fmt.Sprintf("Configuration file (%s|%s)", localnode.AppConfigFile, localnode.CometConfigFile),
+	)
+	nodeConfigSetCmd.Flags().StringVar(&configPreset, "preset", "", "Preset of settings to apply")
+
+	nodeConfigCmd.AddCommand(nodeConfigGetCmd)
+	nodeConfigCmd.AddCommand(nodeConfigSetCmd)
+	nodeCmd.AddCommand(nodeConfigCmd)
+}
@@ -25,10 +25,24 @@ It can be used to test upgrades, deposit or vote for specific or the latest prop
 	chainID string
 	// denom of the chain's fee token.
 	denom string
+	// dryRun defines whether transactions are only printed instead of being broadcast.
+	dryRun bool
+	// dryRunScript is the path of the shell script to write the planned transactions to.
+	dryRunScript string
+	// exclude are the keys, that are not used to sign transactions.
+	exclude []string
+	// feeGranter is the key, that pays the transaction fees through a fee allowance.
+	feeGranter string
+	// from is the key, that is used to sign transactions.
+	from string
 	// home is the home directory of the binary.
 	home string
+	// keys are the keys, that are used to sign transactions.
+	keys []string
 	// keyringBackend is the keyring to use.
 	keyringBackend string
+	// keyringPassphraseFile is the path of a file containing the keyring passphrase.
+	keyringPassphraseFile string
 	// node to post requests and transactions to.
 	node string
 )
@@ -39,7 +53,7 @@ func init() {
 		&appd,
 		"bin",
 		"evmosd",
-		"Name of the binary to be executed",
+		"Name of the binary to be executed or reference of a registered binary (e.g. evmosd@v16.0.3)",
 	)
 	rootCmd.PersistentFlags().StringVar(
 		&chainID,
@@ -53,18 +67,60 @@ func init() {
 		"aevmos",
 		"Fee token denomination of the network",
 	)
+	rootCmd.PersistentFlags().BoolVar(
+		&dryRun,
+		"dry-run",
+		false,
+		"Print all transactions instead of broadcasting them",
+	)
+	rootCmd.PersistentFlags().StringVar(
+		&dryRunScript,
+		"dry-run-script",
+		"",
+		"Write the transactions planned in dry-run mode to the given shell script",
+	)
+	rootCmd.PersistentFlags().StringSliceVar(
+		&exclude,
+		"exclude",
+		nil,
+		"Names, glob patterns or addresses of keys, that are not used to sign transactions",
+	)
+	rootCmd.PersistentFlags().StringVar(
+		&feeGranter,
+		"fee-granter",
+		"",
+		"Name or address of the key, that pays the transaction fees through a fee allowance",
+	)
+	rootCmd.PersistentFlags().StringVar(
+		&from,
+		"from",
+		"",
+		"Name or address of the key to sign transactions with; defaults to the first selected key",
+	)
 	rootCmd.PersistentFlags().StringVar(
 		&home,
 		"home",
 		".tmp-evmosd",
 		"Home directory of the binary",
 	)
+	rootCmd.PersistentFlags().StringSliceVar(
+		&keys,
+		"keys",
+		nil,
+		"Names, glob patterns or addresses of keys to sign transactions with; defaults to all keys",
+	)
 	rootCmd.PersistentFlags().StringVar(
 		&keyringBackend,
 		"keyring-backend",
 		"test",
 		"Keyring to use",
 	)
+	rootCmd.PersistentFlags().StringVar(
+		&keyringPassphraseFile,
+		"keyring-passphrase-file",
+		"",
+		"File containing the passphrase of the keyring; defaults to the "+utils.KeyringPassphraseEnv+" env variable",
+	)
 	rootCmd.PersistentFlags().StringVar(
 		&node,
 		"node",
@@ -75,18 +131,43 @@ func init() {
 	rootCmd.AddCommand(upgradeCmd)
 	rootCmd.AddCommand(depositCmd)
 	rootCmd.AddCommand(voteCmd)
+	rootCmd.AddCommand(snapshotCmd)
+	rootCmd.AddCommand(initNodeCmd)
+	rootCmd.AddCommand(testnetCmd)
+	rootCmd.AddCommand(genesisCmd)
+	rootCmd.AddCommand(forkCmd)
+	rootCmd.AddCommand(nodeCmd)
+	rootCmd.AddCommand(homeCmd)
+	rootCmd.AddCommand(emergencyHaltCmd)
+	rootCmd.AddCommand(buildCmd)
+	rootCmd.AddCommand(versionsCmd)
+	rootCmd.AddCommand(accountsCmd)
+	rootCmd.AddCommand(addrCmd)
+	rootCmd.AddCommand(stakeCmd)
+	rootCmd.AddCommand(validatorCmd)
+	rootCmd.AddCommand(authzCmd)
+	rootCmd.AddCommand(feegrantCmd)
+
+	upgradeCmd.AddCommand(verifyCmd)
 }
 
 // collectConfig returns a BinaryConfig filled with the current configuration options
 // that depend on the passed flags to the given CLI commands.
 func collectConfig() utils.BinaryConfig {
 	return utils.BinaryConfig{
-		Appd:           appd,
-		ChainID:        chainID,
-		Denom:          denom,
-		Home:           home,
-		KeyringBackend: keyringBackend,
-		Node:           node,
+		Appd:                  appd,
+		ChainID:               chainID,
+		Denom:                 denom,
+		DryRun:                dryRun,
+		DryRunScript:          dryRunScript,
+		Exclude:               exclude,
+		FeeGranter:            feeGranter,
+		From:                  from,
+		Home:                  home,
+		Keys:                  keys,
+		KeyringBackend:        keyringBackend,
+		KeyringPassphraseFile: keyringPassphraseFile,
+		Node:                  node,
 	}
 }
 
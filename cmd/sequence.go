@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"github.com/MalteHerrmann/evmos-utils/upgrade"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// sequenceBlocks is the number of blocks that have to be produced after each upgrade.
+var sequenceBlocks int
+
+//nolint:gochecknoglobals // required by cobra
+var sequenceCmd = &cobra.Command{
+	Use:   "sequence SPEC_FILE",
+	Short: "Run a sequence of upgrades",
+	Long: `Run a sequence of upgrades, e.g. to reproduce the upgrade history of a network.
+The sequence is defined in a YAML file containing a list of steps:
+
+  - name: v16.0.0           # name of the upgrade plan (default: version)
+    version: v16.0.0        # version reported by the binary after the upgrade
+    binary: /path/to/evmosd # binary to restart the node with after the upgrade
+
+For each step, the upgrade proposal is submitted and voted on, the node is stopped
+once it halted at the upgrade height, restarted with the step's binary and the upgrade
+is verified. The sequence stops at the first failing step.
+
+The node process is controlled by the tool. If no node is running, it is started
+using the binary configured with --bin.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		if dryRun {
+			return errors.New("dry-run mode is not supported for upgrade sequences")
+		}
+
+		bin, err := utils.NewBinary(collectConfig())
+		if err != nil {
+			return errors.Wrap(err, "error creating binary")
+		}
+
+		steps, err := upgrade.LoadSequence(args[0])
+		if err != nil {
+			return err
+		}
+
+		if err = upgrade.RunSequence(bin, steps, sequenceBlocks); err != nil {
+			return errors.Wrap(err, "error running upgrade sequence")
+		}
+
+		bin.Logger.Info().Msgf("successfully ran %d upgrades", len(steps))
+
+		return nil
+	},
+}
+
+//nolint:gochecknoinits // required by cobra
+func init() {
+	sequenceCmd.Flags().IntVar(
+		&sequenceBlocks, "blocks", 5, "Number of new blocks that have to be produced after each upgrade",
+	)
+
+	upgradeCmd.AddCommand(sequenceCmd)
+}
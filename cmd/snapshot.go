@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/MalteHerrmann/evmos-utils/snapshot"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// snapshotAllowlist is the path to the file containing the allowed changes between snapshots.
+	snapshotAllowlist string
+	// snapshotHeight is the height at which the snapshot is taken.
+	snapshotHeight int
+	// snapshotParams are the modules, whose parameters are recorded in the snapshot.
+	snapshotParams []string
+)
+
+//nolint:gochecknoglobals // required by cobra
+var snapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Record and compare state snapshots",
+	Long: `Record snapshots of the chain state, e.g. before and after an upgrade,
+and compare them to detect unexpected changes.`,
+}
+
+//nolint:gochecknoglobals // required by cobra
+var snapshotTakeCmd = &cobra.Command{
+	Use:   "take NAME",
+	Short: "Record a snapshot of the chain state",
+	Long: `Record the balances and delegations of all accounts in the keyring, the total supply,
+the registered ERC20 token pairs, the status and tally of all governance proposals
+and the parameters of the selected modules at the given height.
+If no height is given, the current height is used.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		bin, err := utils.NewBinary(collectConfig())
+		if err != nil {
+			return errors.Wrap(err, "error creating binary")
+		}
+
+		snap, err := snapshot.Take(bin, args[0], snapshotHeight, snapshotParams)
+		if err != nil {
+			return errors.Wrap(err, "error taking snapshot")
+		}
+
+		if err = snapshot.Save(bin, snap); err != nil {
+			return errors.Wrap(err, "error saving snapshot")
+		}
+
+		bin.Logger.Info().Msgf("stored snapshot %s at height %d with %d entries", snap.Name, snap.Height, len(snap.Entries))
+
+		return nil
+	},
+}
+
+//nolint:gochecknoglobals // required by cobra
+var snapshotDiffCmd = &cobra.Command{
+	Use:   "diff SNAPSHOT_A SNAPSHOT_B",
+	Short: "Compare two snapshots of the chain state",
+	Long: `Compare two snapshots of the chain state and print all changed entries.
+Expected changes, e.g. due to migrations, can be listed in an allowlist file
+with one pattern per line, where "*" matches any sequence of characters
+(e.g. "params.staking.*"). The command fails if there are unexpected changes.`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		bin, err := utils.NewBinary(collectConfig())
+		if err != nil {
+			return errors.Wrap(err, "error creating binary")
+		}
+
+		before, err := snapshot.Load(bin, args[0])
+		if err != nil {
+			return err
+		}
+
+		after, err := snapshot.Load(bin, args[1])
+		if err != nil {
+			return err
+		}
+
+		var allowlist snapshot.Allowlist
+		if snapshotAllowlist != "" {
+			if allowlist, err = snapshot.LoadAllowlist(snapshotAllowlist); err != nil {
+				return err
+			}
+		}
+
+		var unexpected int
+
+		for _, change := range snapshot.Diff(before, after, allowlist) {
+			if !change.Expected {
+				unexpected++
+			}
+
+			if _, err = fmt.Fprintln(cmd.OutOrStdout(), change.String()); err != nil {
+				return errors.Wrap(err, "error printing change")
+			}
+		}
+
+		if unexpected > 0 {
+			return fmt.Errorf("found %d unexpected changes between %s and %s", unexpected, args[0], args[1])
+		}
+
+		bin.Logger.Info().Msgf("no unexpected changes between %s and %s", args[0], args[1])
+
+		return nil
+	},
+}
+
+//nolint:gochecknoinits // required by cobra
+func init() {
+	snapshotTakeCmd.Flags().IntVar(&snapshotHeight, "height", 0, "Height to record the state at (default: current height)")
+	snapshotTakeCmd.Flags().StringSliceVar(
+		&snapshotParams,
+		"params",
+		snapshot.DefaultParamModules,
+		"Modules whose parameters are recorded",
+	)
+	snapshotDiffCmd.Flags().StringVar(&snapshotAllowlist, "allowlist", "", "File containing patterns of expected changes")
+
+	snapshotCmd.AddCommand(snapshotTakeCmd)
+	snapshotCmd.AddCommand(snapshotDiffCmd)
+}
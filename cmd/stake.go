@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/MalteHerrmann/evmos-utils/stake"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// stakeMin is the minimum amount every account has to delegate.
+	stakeMin string
+	// stakeValidator is the validator to delegate to when ensuring delegations.
+	stakeValidator string
+)
+
+//nolint:gochecknoglobals // required by cobra
+var stakeCmd = &cobra.Command{
+	Use:   "stake",
+	Short: "Manage the delegations of the keyring accounts",
+	Long: `Manage the delegations of the selected keyring accounts. All commands are executed for every key
+selected with --from, --keys and --exclude, which defaults to all keys in the keyring.
+Validators can be passed as operator addresses, account addresses or key names.`,
+}
+
+//nolint:gochecknoglobals // required by cobra
+var stakeDelegateCmd = &cobra.Command{
+	Use:     "delegate VALIDATOR AMOUNT",
+	Short:   "Delegate to a validator",
+	Example: "evmos-utils stake delegate evmosvaloper1... 1evmos --keys dev*",
+	Args:    cobra.ExactArgs(2),
+	RunE: func(_ *cobra.Command, args []string) error {
+		return runStakeCommand(func(bin *utils.Binary) error {
+			return stake.Delegate(bin, args[0], args[1])
+		})
+	},
+}
+
+//nolint:gochecknoglobals // required by cobra
+var stakeRedelegateCmd = &cobra.Command{
+	Use:   "redelegate SRC_VALIDATOR DST_VALIDATOR AMOUNT",
+	Short: "Redelegate from one validator to another",
+	Args:  cobra.ExactArgs(3),
+	RunE: func(_ *cobra.Command, args []string) error {
+		return runStakeCommand(func(bin *utils.Binary) error {
+			return stake.Redelegate(bin, args[0], args[1], args[2])
+		})
+	},
+}
+
+//nolint:gochecknoglobals // required by cobra
+var stakeUnbondCmd = &cobra.Command{
+	Use:   "unbond VALIDATOR AMOUNT",
+	Short: "Unbond from a validator",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(_ *cobra.Command, args []string) error {
+		return runStakeCommand(func(bin *utils.Binary) error {
+			return stake.Unbond(bin, args[0], args[1])
+		})
+	},
+}
+
+//nolint:gochecknoglobals // required by cobra
+var stakeWithdrawRewardsCmd = &cobra.Command{
+	Use:   "withdraw-rewards",
+	Short: "Withdraw the pending rewards of all delegations",
+	Args:  cobra.NoArgs,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		return runStakeCommand(stake.WithdrawRewards)
+	},
+}
+
+//nolint:gochecknoglobals // required by cobra
+var stakeEnsureCmd = &cobra.Command{
+	Use:   "ensure",
+	Short: "Ensure that every account delegates a minimum amount",
+	Long: `Delegate the missing amount from every account, that delegates less than the minimum amount,
+to the given validator or a random bonded validator. Afterwards, every key can vote on proposals.`,
+	Example: "evmos-utils stake ensure --min 1evmos",
+	Args:    cobra.NoArgs,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		return runStakeCommand(func(bin *utils.Binary) error {
+			delegated, err := stake.Ensure(bin, stakeMin, stakeValidator)
+			if err != nil {
+				return err
+			}
+
+			if len(delegated) > 0 {
+				bin.Logger.Info().Msgf("delegated from %s", strings.Join(delegated, ", "))
+			}
+
+			return nil
+		})
+	},
+}
+
+// runStakeCommand creates the binary and runs the given staking command.
+func runStakeCommand(run func(bin *utils.Binary) error) error {
+	bin, err := utils.NewBinary(collectConfig())
+	if err != nil {
+		return errors.Wrap(err, "error creating binary")
+	}
+
+	return run(bin)
+}
+
+//nolint:gochecknoinits // required by cobra
+func init() {
+	stakeEnsureCmd.Flags().StringVar(&stakeMin, "min", "1evmos", "Minimum amount every account has to delegate")
+	stakeEnsureCmd.Flags().StringVar(
+		&stakeValidator, "validator", "", "Validator to delegate to; defaults to a random bonded validator",
+	)
+
+	stakeCmd.AddCommand(stakeDelegateCmd)
+	stakeCmd.AddCommand(stakeRedelegateCmd)
+	stakeCmd.AddCommand(stakeUnbondCmd)
+	stakeCmd.AddCommand(stakeWithdrawRewardsCmd)
+	stakeCmd.AddCommand(stakeEnsureCmd)
+}
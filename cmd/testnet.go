@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"time"
+
+	"github.com/MalteHerrmann/evmos-utils/testnet"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// testnetOptions are the options to create a local testnet.
+	testnetOptions testnet.CreateOptions
+	// testnetStart defines whether the testnet is started after it was created.
+	testnetStart bool
+)
+
+//nolint:gochecknoglobals // required by cobra
+var testnetCmd = &cobra.Command{
+	Use:   "testnet",
+	Short: "Manage a local multi-validator testnet",
+	Long: `Create, start and stop a local testnet with multiple validators.
+The testnet is created in the configured home directory, which contains one home directory
+per node. When using this home directory with the other commands, the keys of all nodes are used,
+e.g. to vote with all validators.`,
+}
+
+//nolint:gochecknoglobals // required by cobra
+var testnetCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a local multi-validator testnet",
+	Long: `Create a local testnet with the given number of validators. Every node has its own home
+directory and keyring, uses distinct ports and is connected to all other nodes as persistent peer.
+All nodes share the same genesis, in which the validators have unequal stake.`,
+	Args: cobra.NoArgs,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		bin, err := utils.NewUninitializedBinary(collectConfig())
+		if err != nil {
+			return errors.Wrap(err, "error creating binary")
+		}
+
+		network, err := testnet.Create(bin, testnetOptions)
+		if err != nil {
+			return errors.Wrap(err, "error creating testnet")
+		}
+
+		bin.Logger.Info().Msgf("created testnet with %d validators in %s", len(network.Nodes), bin.Config.Home)
+
+		if !testnetStart {
+			return nil
+		}
+
+		return testnet.Start(bin, network)
+	},
+}
+
+//nolint:gochecknoglobals // required by cobra
+var testnetStartCmd = &cobra.Command{
+	Use:   "start",
+	Short: "Start all nodes of the local testnet",
+	Args:  cobra.NoArgs,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		bin, err := utils.NewUninitializedBinary(collectConfig())
+		if err != nil {
+			return errors.Wrap(err, "error creating binary")
+		}
+
+		network, err := testnet.Load(bin)
+		if err != nil {
+			return err
+		}
+
+		return testnet.Start(bin, network)
+	},
+}
+
+//nolint:gochecknoglobals // required by cobra
+var testnetStopCmd = &cobra.Command{
+	Use:   "stop",
+	Short: "Stop all nodes of the local testnet",
+	Args:  cobra.NoArgs,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		bin, err := utils.NewUninitializedBinary(collectConfig())
+		if err != nil {
+			return errors.Wrap(err, "error creating binary")
+		}
+
+		network, err := testnet.Load(bin)
+		if err != nil {
+			return err
+		}
+
+		return testnet.Stop(bin, network)
+	},
+}
+
+//nolint:gochecknoinits // required by cobra
+func init() {
+	testnetCreateCmd.Flags().IntVar(&testnetOptions.Validators, "validators", 4, "Number of validators")
+	testnetCreateCmd.Flags().StringVar(
+		&testnetOptions.Balance, "balance", "100000000000000000000000000", "Amount to fund every validator key with",
+	)
+	testnetCreateCmd.Flags().StringVar(
+		&testnetOptions.SelfDelegation, "self-delegation", "1000000000000000000000",
+		"Self-delegation of the last validator; the validator with index i delegates (N-i) times this amount",
+	)
+	testnetCreateCmd.Flags().StringVar(
+		&testnetOptions.MinDeposit, "min-deposit", "10000000", "Minimum deposit for governance proposals",
+	)
+	testnetCreateCmd.Flags().DurationVar(
+		&testnetOptions.VotingPeriod, "voting-period", 30*time.Second, "Voting period of governance proposals",
+	)
+	testnetCreateCmd.Flags().BoolVar(&testnetOptions.Overwrite, "overwrite", false, "Remove an existing testnet")
+	testnetCreateCmd.Flags().BoolVar(&testnetStart, "start", true, "Start the nodes after creating the testnet")
+
+	testnetCmd.AddCommand(testnetCreateCmd)
+	testnetCmd.AddCommand(testnetStartCmd)
+	testnetCmd.AddCommand(testnetStopCmd)
+}
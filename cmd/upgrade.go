@@ -4,18 +4,25 @@ import (
 	"fmt"
 	"regexp"
 
-	"github.com/MalteHerrmann/evmos-utils/gov"
+	"github.com/MalteHerrmann/evmos-utils/upgrade"
 	"github.com/MalteHerrmann/evmos-utils/utils"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
+// resumeUpgrade defines whether to resume a previously failed upgrade preparation.
+var resumeUpgrade bool
+
 //nolint:gochecknoglobals // required by cobra
 var upgradeCmd = &cobra.Command{
 	Use:   "upgrade TARGET_VERSION",
 	Short: "Prepare an upgrade of a node",
 	Long: `Prepare an upgrade of a node by submitting a governance proposal, 
-voting for it using all keys of in the keyring and having it pass.`,
+voting for it using all keys of in the keyring and having it pass.
+
+Every completed step is recorded in a journal in the home directory. If the preparation
+failed, e.g. because the deposit could not be made, it can be resumed with --resume.
+If there is already a pending upgrade proposal for the target version, it is reused.`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(_ *cobra.Command, args []string) error {
 		bin, err := utils.NewBinary(collectConfig())
@@ -28,7 +35,7 @@ voting for it using all keys of in the keyring and having it pass.`,
 			return fmt.Errorf("invalid target version: %s; please use the format vX.Y.Z(-rc*)", targetVersion)
 		}
 
-		if err = upgradeLocalNode(bin, targetVersion); err != nil {
+		if _, err = upgrade.Prepare(bin, targetVersion, resumeUpgrade); err != nil {
 			return errors.Wrap(err, "error upgrading local node")
 		}
 
@@ -38,32 +45,7 @@ voting for it using all keys of in the keyring and having it pass.`,
 	},
 }
 
-// upgradeLocalNode prepares upgrading the local node to the target version
-// by submitting the upgrade proposal and voting on it using all testing accounts.
-func upgradeLocalNode(bin *utils.Binary, targetVersion string) error {
-	currentHeight, err := utils.GetCurrentHeight(bin)
-	if err != nil {
-		return errors.Wrap(err, "error getting current height")
-	}
-
-	upgradeHeight := currentHeight + utils.DeltaHeight
-
-	bin.Logger.Info().Msg("submitting upgrade proposal...")
-
-	proposalID, err := gov.SubmitUpgradeProposal(bin, targetVersion, upgradeHeight)
-	if err != nil {
-		return errors.Wrap(err, "error executing upgrade proposal")
-	}
-
-	bin.Logger.Info().Msgf("scheduled upgrade to %s at height %d.\n", targetVersion, upgradeHeight)
-
-	if _, err = gov.Deposit(bin, []string{}); err != nil {
-		return errors.Wrapf(err, "error depositing for proposal %d", proposalID)
-	}
-
-	if err = gov.SubmitAllVotesForProposal(bin, proposalID); err != nil {
-		return errors.Wrapf(err, "error submitting votes for proposal %d", proposalID)
-	}
-
-	return nil
+//nolint:gochecknoinits // required by cobra
+func init() {
+	upgradeCmd.Flags().BoolVar(&resumeUpgrade, "resume", false, "Resume from the last completed step of a previous run")
 }
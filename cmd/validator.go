@@ -0,0 +1,169 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/MalteHerrmann/evmos-utils/validator"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// validatorCreateOptions are the options to create a new validator.
+	validatorCreateOptions validator.CreateOptions
+	// validatorEditOptions are the options to edit a validator.
+	validatorEditOptions validator.EditOptions
+	// validatorListOutput is the output format of the validator list.
+	validatorListOutput string
+)
+
+//nolint:gochecknoglobals // required by cobra
+var validatorCmd = &cobra.Command{
+	Use:   "validator",
+	Short: "Create and manage validators on a local network",
+}
+
+//nolint:gochecknoglobals // required by cobra
+var validatorCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Add a new validator node to the local testnet",
+	Long: `Add a new node to the local testnet in the configured home directory and make it a validator.
+The validator key of the new node is funded from the --from key, the node is started as a peer
+of the existing nodes and the validator is created with the given commission and self-delegation.`,
+	Example: "evmos-utils validator create --home .tmp-testnet --self-delegation 10evmos --commission-rate 0.05",
+	Args:    cobra.NoArgs,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		bin, err := utils.NewBinary(collectConfig())
+		if err != nil {
+			return errors.Wrap(err, "error creating binary")
+		}
+
+		n, err := validator.Create(bin, validatorCreateOptions)
+		if err != nil {
+			return errors.Wrap(err, "error creating validator")
+		}
+
+		bin.Logger.Info().Msgf("created validator %s on %s (RPC: %s)", n.KeyName, n.Name, n.RPCAddress())
+
+		return nil
+	},
+}
+
+//nolint:gochecknoglobals // required by cobra
+var validatorEditCmd = &cobra.Command{
+	Use:     "edit",
+	Short:   "Edit the validator operated by the --from key",
+	Example: "evmos-utils validator edit --from val1 --moniker new-name",
+	Args:    cobra.NoArgs,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		bin, err := utils.NewBinary(collectConfig())
+		if err != nil {
+			return errors.Wrap(err, "error creating binary")
+		}
+
+		return validator.Edit(bin, validatorEditOptions)
+	},
+}
+
+//nolint:gochecknoglobals // required by cobra
+var validatorUnjailCmd = &cobra.Command{
+	Use:     "unjail",
+	Short:   "Unjail the validator operated by the --from key",
+	Example: "evmos-utils validator unjail --from val1",
+	Args:    cobra.NoArgs,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		bin, err := utils.NewBinary(collectConfig())
+		if err != nil {
+			return errors.Wrap(err, "error creating binary")
+		}
+
+		return validator.Unjail(bin)
+	},
+}
+
+//nolint:gochecknoglobals // required by cobra
+var validatorListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the validators with their voting power, jailed status and missed blocks",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		if validatorListOutput != "table" && validatorListOutput != "json" {
+			return fmt.Errorf("invalid output format: %s; please use table or json", validatorListOutput)
+		}
+
+		bin, err := utils.NewUninitializedBinary(collectConfig())
+		if err != nil {
+			return errors.Wrap(err, "error creating binary")
+		}
+
+		infos, err := validator.List(bin)
+		if err != nil {
+			return err
+		}
+
+		if validatorListOutput == "json" {
+			var bz []byte
+			if bz, err = json.MarshalIndent(infos, "", "  "); err != nil {
+				return errors.Wrap(err, "error marshalling validators")
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+
+			return errors.Wrap(err, "error printing validators")
+		}
+
+		writer := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+
+		if _, err = fmt.Fprintln(writer, "MONIKER\tOPERATOR\tSTATUS\tPOWER\tJAILED\tMISSED BLOCKS"); err != nil {
+			return errors.Wrap(err, "error printing validators")
+		}
+
+		for _, info := range infos {
+			if _, err = fmt.Fprintf(writer, "%s\t%s\t%s\t%d\t%t\t%d\n",
+				info.Moniker, info.OperatorAddress, info.Status, info.VotingPower, info.Jailed, info.MissedBlocks,
+			); err != nil {
+				return errors.Wrap(err, "error printing validators")
+			}
+		}
+
+		return errors.Wrap(writer.Flush(), "error printing validators")
+	},
+}
+
+//nolint:gochecknoinits // required by cobra
+func init() {
+	validatorCreateCmd.Flags().StringVar(&validatorCreateOptions.Moniker, "moniker", "", "Moniker of the validator")
+	validatorCreateCmd.Flags().StringVar(
+		&validatorCreateOptions.Balance, "balance", "20evmos", "Amount to fund the validator key with",
+	)
+	validatorCreateCmd.Flags().StringVar(
+		&validatorCreateOptions.SelfDelegation, "self-delegation", "10evmos", "Self-delegation of the validator",
+	)
+	validatorCreateCmd.Flags().StringVar(
+		&validatorCreateOptions.CommissionRate, "commission-rate", "0.1", "Initial commission rate",
+	)
+	validatorCreateCmd.Flags().StringVar(
+		&validatorCreateOptions.CommissionMaxRate, "commission-max-rate", "0.2", "Maximum commission rate",
+	)
+	validatorCreateCmd.Flags().StringVar(
+		&validatorCreateOptions.CommissionMaxChangeRate, "commission-max-change-rate", "0.01",
+		"Maximum daily change of the commission rate",
+	)
+	validatorCreateCmd.Flags().StringVar(
+		&validatorCreateOptions.MinSelfDelegation, "min-self-delegation", "1", "Minimum self-delegation",
+	)
+
+	validatorEditCmd.Flags().StringVar(&validatorEditOptions.Moniker, "moniker", "", "New moniker")
+	validatorEditCmd.Flags().StringVar(&validatorEditOptions.Details, "details", "", "New details")
+	validatorEditCmd.Flags().StringVar(&validatorEditOptions.CommissionRate, "commission-rate", "", "New commission rate")
+
+	validatorListCmd.Flags().StringVarP(&validatorListOutput, "output", "o", "table", "Output format (table|json)")
+
+	validatorCmd.AddCommand(validatorCreateCmd)
+	validatorCmd.AddCommand(validatorEditCmd)
+	validatorCmd.AddCommand(validatorUnjailCmd)
+	validatorCmd.AddCommand(validatorListCmd)
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/MalteHerrmann/evmos-utils/upgrade"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// verifyBlocks is the number of blocks that have to be produced after the upgrade.
+	verifyBlocks int
+	// verifyOutput is the output format of the verification report.
+	verifyOutput string
+)
+
+//nolint:gochecknoglobals // required by cobra
+var verifyCmd = &cobra.Command{
+	Use:   "verify UPGRADE_NAME",
+	Short: "Verify that an upgrade was applied successfully",
+	Long: `Verify that the upgrade with the given name was applied successfully after the node
+was restarted with the new version. This checks that the upgrade was applied at a given height,
+that the module versions moved forward compared to the snapshot taken before submitting
+the upgrade proposal and that the chain keeps producing blocks.
+
+The resulting report can be printed as text or JSON, e.g. for usage in CI pipelines.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if verifyOutput != "text" && verifyOutput != "json" {
+			return fmt.Errorf("invalid output format: %s; please use text or json", verifyOutput)
+		}
+
+		bin, err := utils.NewBinary(collectConfig())
+		if err != nil {
+			return errors.Wrap(err, "error creating binary")
+		}
+
+		report := upgrade.Verify(bin, args[0], verifyBlocks)
+
+		out := report.String()
+		if verifyOutput == "json" {
+			if out, err = report.JSON(); err != nil {
+				return err
+			}
+		}
+
+		if _, err = fmt.Fprintln(cmd.OutOrStdout(), out); err != nil {
+			return errors.Wrap(err, "error printing report")
+		}
+
+		if !report.Passed {
+			return fmt.Errorf("verification of upgrade %s failed", args[0])
+		}
+
+		return nil
+	},
+}
+
+//nolint:gochecknoinits // required by cobra
+func init() {
+	verifyCmd.Flags().IntVar(&verifyBlocks, "blocks", 5, "Number of new blocks that have to be produced")
+	verifyCmd.Flags().StringVarP(&verifyOutput, "output", "o", "text", "Output format of the report (text|json)")
+}
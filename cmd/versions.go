@@ -0,0 +1,165 @@
+package cmd
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// versionName is the name to register a binary with.
+var versionName string
+
+//nolint:gochecknoglobals // required by cobra
+var versionsCmd = &cobra.Command{
+	Use:   "versions",
+	Short: "Manage a local registry of binary versions",
+	Long: `Keep versioned binaries in a managed directory in the user's home directory.
+Registered binaries can be passed as NAME@VERSION with --bin, e.g. "--bin evmosd@v16.0.3".
+After selecting a version with "versions use", passing only the name with --bin uses the selected version.`,
+}
+
+//nolint:gochecknoglobals // required by cobra
+var versionsAddCmd = &cobra.Command{
+	Use:   "add PATH",
+	Short: "Add a binary to the registry",
+	Long: `Copy the binary at the given path into the managed directory and register it with the version
+it reports, its detailed version information and its checksum.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		registry, err := loadVersionRegistry()
+		if err != nil {
+			return err
+		}
+
+		binaryVersion, err := registry.Add(args[0], versionName)
+		if err != nil {
+			return errors.Wrap(err, "error adding binary")
+		}
+
+		if err = registry.Save(); err != nil {
+			return err
+		}
+
+		logger := utils.NewLogger()
+		logger.Info().Msgf("registered %s", binaryVersion.Ref())
+
+		return nil
+	},
+}
+
+//nolint:gochecknoglobals // required by cobra
+var versionsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List all registered binaries",
+	Long:  "List all registered binaries and check that they were not modified since they were added.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		registry, err := loadVersionRegistry()
+		if err != nil {
+			return err
+		}
+
+		writer := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+
+		if _, err = fmt.Fprintln(writer, "REFERENCE\tSELECTED\tCHECKSUM\tPATH"); err != nil {
+			return errors.Wrap(err, "error printing versions")
+		}
+
+		for _, binaryVersion := range registry.Versions {
+			selected := ""
+			if registry.Selected[binaryVersion.Name] == binaryVersion.Version {
+				selected = "*"
+			}
+
+			checksum := "ok"
+			if verifyErr := binaryVersion.Verify(); verifyErr != nil {
+				checksum = "modified"
+			}
+
+			if _, err = fmt.Fprintf(
+				writer, "%s\t%s\t%s\t%s\n", binaryVersion.Ref(), selected, checksum, binaryVersion.Path,
+			); err != nil {
+				return errors.Wrap(err, "error printing versions")
+			}
+		}
+
+		return errors.Wrap(writer.Flush(), "error printing versions")
+	},
+}
+
+//nolint:gochecknoglobals // required by cobra
+var versionsUseCmd = &cobra.Command{
+	Use:   "use NAME@VERSION",
+	Short: "Select the version to use for a binary name",
+	Long:  "Select the version, that is used when passing only the name of the binary with --bin.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		registry, err := loadVersionRegistry()
+		if err != nil {
+			return err
+		}
+
+		binaryVersion, err := registry.Use(args[0])
+		if err != nil {
+			return err
+		}
+
+		if err = registry.Save(); err != nil {
+			return err
+		}
+
+		logger := utils.NewLogger()
+		logger.Info().Msgf("using %s for --bin %s", binaryVersion.Ref(), binaryVersion.Name)
+
+		return nil
+	},
+}
+
+//nolint:gochecknoglobals // required by cobra
+var versionsPathCmd = &cobra.Command{
+	Use:   "path NAME@VERSION",
+	Short: "Print the path of a registered binary",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		registry, err := loadVersionRegistry()
+		if err != nil {
+			return err
+		}
+
+		binaryVersion, err := registry.GetByRef(args[0])
+		if err != nil {
+			return err
+		}
+
+		if err = binaryVersion.Verify(); err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), binaryVersion.Path)
+
+		return errors.Wrap(err, "error printing path")
+	},
+}
+
+// loadVersionRegistry loads the version registry from the managed directory.
+func loadVersionRegistry() (*utils.VersionRegistry, error) {
+	dir, err := utils.GetVersionsDir()
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.LoadVersionRegistry(dir)
+}
+
+//nolint:gochecknoinits // required by cobra
+func init() {
+	versionsAddCmd.Flags().StringVar(&versionName, "name", "", "Name to register the binary with (default: file name)")
+
+	versionsCmd.AddCommand(versionsAddCmd)
+	versionsCmd.AddCommand(versionsListCmd)
+	versionsCmd.AddCommand(versionsUseCmd)
+	versionsCmd.AddCommand(versionsPathCmd)
+}
@@ -6,12 +6,21 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var (
+	// voteViaAuthz is the grantee, that executes the votes on behalf of the voting keys.
+	voteViaAuthz string
+)
+
 //nolint:gochecknoglobals // required by cobra
 var voteCmd = &cobra.Command{
 	Use:   "vote [PROPOSAL_ID]",
 	Short: "Vote for a governance proposal",
-	Long: `Vote for a governance proposal with all keys in the keyring.
-If no proposal ID is passed, the latest proposal on chain is queried and used.`,
+	Long: `Vote for a governance proposal with all keys in the keyring, that have delegations.
+The voting keys can be restricted with --from, --keys and --exclude.
+If no proposal ID is passed, the latest proposal on chain is queried and used.
+With --via-authz, the votes are wrapped in authz executions sent by the given grantee on behalf of
+all accounts, that granted it the authorization to vote (see authz grant-vote). The granters are
+queried on chain, so they do not have to be in the keyring.`,
 	Args: cobra.RangeArgs(0, 1),
 	Run: func(_ *cobra.Command, args []string) {
 		bin, err := utils.NewBinary(collectConfig())
@@ -21,7 +30,13 @@ If no proposal ID is passed, the latest proposal on chain is queried and used.`,
 			return
 		}
 
-		proposalID, err := gov.SubmitAllVotes(bin, args)
+		var proposalID int
+		if voteViaAuthz != "" {
+			proposalID, err = gov.SubmitAllVotesViaAuthz(bin, args, voteViaAuthz)
+		} else {
+			proposalID, err = gov.SubmitAllVotes(bin, args)
+		}
+
 		if err != nil {
 			bin.Logger.Error().Msgf("error submitting votes: %v", err)
 
@@ -31,3 +46,8 @@ If no proposal ID is passed, the latest proposal on chain is queried and used.`,
 		bin.Logger.Info().Msgf("successfully submitted votes for proposal %d", proposalID)
 	},
 }
+
+//nolint:gochecknoinits // required by cobra
+func init() {
+	voteCmd.Flags().StringVar(&voteViaAuthz, "via-authz", "", "Key of the grantee, that executes the votes via authz")
+}
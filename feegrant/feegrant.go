@@ -0,0 +1,106 @@
+package feegrant
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/pkg/errors"
+)
+
+// Setup grants a basic fee allowance from the given granter to all selected accounts,
+// so that they can send transactions with the granter passed as --fee-granter.
+// If the spend limit is empty, the allowance is unlimited. Existing allowances are kept.
+//
+// All grants are sent by the granter, so this waits for every transaction to be included
+// in a block before sending the next one, to avoid account sequence mismatches.
+func Setup(bin *utils.Binary, granter, spendLimit string) error {
+	granterAcc, err := utils.FindAccount(bin.Accounts, granter)
+	if err != nil {
+		return errors.Wrap(err, "error finding granter")
+	}
+
+	var limitArgs []string
+
+	if spendLimit != "" {
+		limit, err := utils.ParseAmount(spendLimit, bin.Config.Denom)
+		if err != nil {
+			return err
+		}
+
+		limitArgs = []string{"--spend-limit", limit.String()}
+	}
+
+	grantees, err := GetGrantees(bin, granterAcc)
+	if err != nil {
+		return err
+	}
+
+	if len(grantees) == 0 {
+		return errors.New("no accounts selected to grant a fee allowance to")
+	}
+
+	var failed int
+
+	for _, grantee := range grantees {
+		if err = grantAllowance(bin, granterAcc, grantee, limitArgs); err != nil {
+			bin.Logger.Error().Msgf("could not grant fee allowance to key %s: %v", grantee.Name, err)
+
+			failed++
+		}
+	}
+
+	if failed == len(grantees) {
+		return errors.New("could not grant a fee allowance to any of the selected keys, please check logs")
+	}
+
+	return nil
+}
+
+// GetGrantees returns the selected accounts, that are granted a fee allowance by the given granter.
+func GetGrantees(bin *utils.Binary, granter utils.Account) ([]utils.Account, error) {
+	accounts, err := bin.GetSelectedAccounts()
+	if err != nil {
+		return nil, err
+	}
+
+	grantees := make([]utils.Account, 0, len(accounts))
+
+	for _, acc := range accounts {
+		if acc.Address != granter.Address {
+			grantees = append(grantees, acc)
+		}
+	}
+
+	return grantees, nil
+}
+
+// grantAllowance grants a basic fee allowance from the granter to the grantee
+// and waits for the transaction to be included in a block.
+func grantAllowance(bin *utils.Binary, granter, grantee utils.Account, limitArgs []string) error {
+	subcommand := []string{"tx", "feegrant", "grant", granter.Address, grantee.Address, "--output", "json"}
+
+	out, err := utils.ExecuteTx(bin, utils.TxArgs{
+		Subcommand: append(subcommand, limitArgs...),
+		From:       granter.Name,
+		Quiet:      true,
+	})
+	if err == nil && !bin.Config.DryRun {
+		_, err = utils.GetTxEvents(bin, out)
+	}
+
+	switch {
+	case err == nil && bin.Config.DryRun:
+		return nil
+	case err == nil:
+		bin.Logger.Info().Msgf("granted fee allowance from %s to key %s", granter.Name, grantee.Name)
+
+		return nil
+	case strings.Contains(out+err.Error(), "fee allowance already exists"):
+		bin.Logger.Info().Msgf("key %s already has a fee allowance from %s", grantee.Name, granter.Name)
+
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(out))
+	}
+}
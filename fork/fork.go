@@ -0,0 +1,254 @@
+package fork
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/MalteHerrmann/evmos-utils/genesis"
+	"github.com/MalteHerrmann/evmos-utils/node"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	// bondedPoolName is the name of the module account holding the bonded tokens.
+	bondedPoolName = "bonded_tokens_pool"
+	// notBondedPoolName is the name of the module account holding the unbonding tokens.
+	notBondedPoolName = "not_bonded_tokens_pool"
+	// distributionName is the name of the module account holding the rewards and the community pool.
+	distributionName = "distribution"
+)
+
+// Options are the options to create a local fork.
+type Options struct {
+	node.InitOptions
+
+	// GenesisFile is the path of an exported genesis file to fork from.
+	GenesisFile string
+	// ExportHome is the home directory of a stopped node, whose state is exported to fork from.
+	ExportHome string
+	// ExportHeight is the height to export the state at. If zero, the latest height is exported.
+	ExportHeight int
+}
+
+// Create creates a single-validator local fork in the configured home directory
+// from the given exported genesis or the exported state of the given node home.
+// It keeps all accounts and their liquid balances, replaces the validator set with a validator using
+// the first local test key and shortens the governance periods. Delegated and unbonding tokens
+// as well as pending rewards are removed together with the validator set.
+func Create(bin *utils.Binary, opts Options) error {
+	exported, err := loadExportedGenesis(bin, opts)
+	if err != nil {
+		return err
+	}
+
+	if err = ResetValidatorSet(exported); err != nil {
+		return errors.Wrap(err, "error replacing the validator set")
+	}
+
+	opts.Genesis = exported
+
+	return node.Init(bin, opts.InitOptions)
+}
+
+// loadExportedGenesis loads the exported genesis either from the given file
+// or by exporting the state of the given node home.
+func loadExportedGenesis(bin *utils.Binary, opts Options) (genesis.Genesis, error) {
+	switch {
+	case opts.GenesisFile != "" && opts.ExportHome != "":
+		return nil, errors.New("only one of the genesis file and the export home can be given")
+	case opts.GenesisFile != "":
+		return genesis.Load(opts.GenesisFile)
+	case opts.ExportHome != "":
+		return Export(bin, opts.ExportHome, opts.ExportHeight)
+	default:
+		return nil, errors.New("either a genesis file or an export home is required")
+	}
+}
+
+// Export exports the state of the node with the given home directory at the given height.
+// If the height is zero, the latest height is exported. The node has to be stopped.
+func Export(bin *utils.Binary, home string, height int) (genesis.Genesis, error) {
+	tmpDir, err := os.MkdirTemp("", "evmos-utils-export")
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating temporary directory")
+	}
+	defer func() {
+		if removeErr := os.RemoveAll(tmpDir); removeErr != nil {
+			bin.Logger.Error().Msgf("error removing temporary directory: %s", removeErr)
+		}
+	}()
+
+	exportPath := filepath.Join(tmpDir, "genesis.json")
+	args := []string{"export", "--home", home, "--output-document", exportPath}
+
+	if height > 0 {
+		args = append(args, "--height", strconv.Itoa(height))
+	}
+
+	bin.Logger.Info().Msgf("exporting state from %s", home)
+
+	if _, err = utils.ExecuteBinaryCmd(bin, utils.BinaryCmdArgs{Subcommand: args}); err != nil {
+		return nil, errors.Wrap(err, "error exporting state; make sure the node is stopped")
+	}
+
+	return genesis.Load(exportPath)
+}
+
+// ResetValidatorSet removes all validators and the related staking, distribution and slashing state
+// from the given exported genesis, so that a new validator can be created from a genesis transaction.
+// The tokens held in the staking pools are burned and the distribution module balance is reduced
+// to the community pool, because the delegations and rewards no longer exist. Afterwards, the total
+// supply is set to the sum of the remaining balances.
+func ResetValidatorSet(g genesis.Genesis) error {
+	for path, value := range map[string]interface{}{
+		"validators":                                               []interface{}{},
+		"app_state.staking.validators":                             []interface{}{},
+		"app_state.staking.delegations":                            []interface{}{},
+		"app_state.staking.unbonding_delegations":                  []interface{}{},
+		"app_state.staking.redelegations":                          []interface{}{},
+		"app_state.staking.last_validator_powers":                  []interface{}{},
+		"app_state.staking.last_total_power":                       "0",
+		"app_state.staking.exported":                               false,
+		"app_state.distribution.outstanding_rewards":               []interface{}{},
+		"app_state.distribution.validator_accumulated_commissions": []interface{}{},
+		"app_state.distribution.validator_historical_rewards":      []interface{}{},
+		"app_state.distribution.validator_current_rewards":         []interface{}{},
+		"app_state.distribution.delegator_starting_infos":          []interface{}{},
+		"app_state.distribution.validator_slash_events":            []interface{}{},
+		"app_state.distribution.previous_proposer":                 "",
+		"app_state.slashing.signing_infos":                         []interface{}{},
+		"app_state.slashing.missed_blocks":                         []interface{}{},
+	} {
+		g.SetIfExists(path, value)
+	}
+
+	g["genesis_time"] = time.Now().UTC().Format(time.RFC3339Nano)
+
+	communityPool, err := getCommunityPool(g)
+	if err != nil {
+		return err
+	}
+
+	for name, coins := range map[string][]interface{}{
+		bondedPoolName:    []interface{}{},
+		notBondedPoolName: []interface{}{},
+		distributionName:  communityPool,
+	} {
+		if err = setModuleBalance(g, name, coins); err != nil {
+			return err
+		}
+	}
+
+	return g.UpdateSupply()
+}
+
+// getCommunityPool returns the truncated coins of the community pool in the given genesis.
+func getCommunityPool(g genesis.Genesis) ([]interface{}, error) {
+	pool, err := g.Get("app_state.distribution.fee_pool.community_pool")
+	if err != nil {
+		return nil, err
+	}
+
+	decCoins, ok := pool.([]interface{})
+	if !ok {
+		return nil, errors.New("unexpected format of the community pool")
+	}
+
+	coins := make([]interface{}, 0, len(decCoins))
+
+	for _, entry := range decCoins {
+		decCoin, ok := entry.(map[string]interface{})
+		if !ok {
+			return nil, errors.New("unexpected format of the community pool")
+		}
+
+		amount, err := sdk.NewDecFromStr(fmt.Sprintf("%v", decCoin["amount"]))
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid community pool amount")
+		}
+
+		if truncated := amount.TruncateInt(); truncated.IsPositive() {
+			coins = append(coins, map[string]interface{}{"denom": decCoin["denom"], "amount": truncated.String()})
+		}
+	}
+
+	return coins, nil
+}
+
+// setModuleBalance sets the balance of the module account with the given name to the given coins.
+// If the coins are empty, the balance entry is removed.
+func setModuleBalance(g genesis.Genesis, name string, coins []interface{}) error {
+	address, err := getModuleAddress(g, name)
+	if err != nil {
+		return err
+	}
+
+	if address == "" {
+		return nil
+	}
+
+	balances, err := g.Get("app_state.bank.balances")
+	if err != nil {
+		return err
+	}
+
+	entries, ok := balances.([]interface{})
+	if !ok {
+		return errors.New("unexpected format of the bank balances")
+	}
+
+	updated := make([]interface{}, 0, len(entries)+1)
+
+	for _, entry := range entries {
+		balance, ok := entry.(map[string]interface{})
+		if !ok || balance["address"] != address {
+			updated = append(updated, entry)
+		}
+	}
+
+	if len(coins) > 0 {
+		updated = append(updated, map[string]interface{}{"address": address, "coins": coins})
+	}
+
+	return g.Set("app_state.bank.balances", updated)
+}
+
+// getModuleAddress returns the address of the module account with the given name
+// or an empty string if there is no such account in the genesis.
+func getModuleAddress(g genesis.Genesis, name string) (string, error) {
+	accounts, err := g.Get("app_state.auth.accounts")
+	if err != nil {
+		return "", err
+	}
+
+	entries, ok := accounts.([]interface{})
+	if !ok {
+		return "", errors.New("unexpected format of the auth accounts")
+	}
+
+	for _, entry := range entries {
+		account, ok := entry.(map[string]interface{})
+		if !ok || account["name"] != name {
+			continue
+		}
+
+		baseAccount, ok := account["base_account"].(map[string]interface{})
+		if !ok {
+			return "", fmt.Errorf("module account %s has no base account", name)
+		}
+
+		address, ok := baseAccount["address"].(string)
+		if !ok {
+			return "", fmt.Errorf("module account %s has no address", name)
+		}
+
+		return address, nil
+	}
+
+	return "", nil
+}
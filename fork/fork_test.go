@@ -0,0 +1,97 @@
+package fork_test
+
+import (
+	"testing"
+
+	"github.com/MalteHerrmann/evmos-utils/fork"
+	"github.com/MalteHerrmann/evmos-utils/genesis"
+	"github.com/stretchr/testify/require"
+)
+
+const exportedGenesis = `{
+  "chain_id": "evmos_9001-2",
+  "genesis_time": "2022-04-27T17:00:00Z",
+  "initial_height": "1000",
+  "validators": [{"address": "ABCD", "power": "100"}],
+  "app_state": {
+    "auth": {
+      "accounts": [
+        {"@type": "/ethermint.types.v1.EthAccount", "base_account": {"address": "evmos1user"}},
+        {
+          "@type": "/cosmos.auth.v1beta1.ModuleAccount",
+          "base_account": {"address": "evmos1bonded"},
+          "name": "bonded_tokens_pool"
+        },
+        {
+          "@type": "/cosmos.auth.v1beta1.ModuleAccount",
+          "base_account": {"address": "evmos1distr"},
+          "name": "distribution"
+        }
+      ]
+    },
+    "bank": {
+      "balances": [
+        {"address": "evmos1user", "coins": [{"denom": "aevmos", "amount": "100"}]},
+        {"address": "evmos1bonded", "coins": [{"denom": "aevmos", "amount": "5000"}]},
+        {"address": "evmos1distr", "coins": [{"denom": "aevmos", "amount": "321"}]}
+      ],
+      "supply": [{"denom": "aevmos", "amount": "5421"}]
+    },
+    "distribution": {
+      "fee_pool": {"community_pool": [{"denom": "aevmos", "amount": "300.750000000000000000"}]},
+      "outstanding_rewards": [{"validator_address": "evmosvaloper1abc"}],
+      "previous_proposer": "evmosvalcons1abc"
+    },
+    "slashing": {
+      "signing_infos": [{"address": "evmosvalcons1abc"}],
+      "missed_blocks": []
+    },
+    "staking": {
+      "validators": [{"operator_address": "evmosvaloper1abc"}],
+      "delegations": [{"delegator_address": "evmos1user"}],
+      "last_total_power": "100",
+      "exported": true
+    }
+  }
+}`
+
+func TestResetValidatorSet(t *testing.T) {
+	t.Parallel()
+
+	genesisState, err := genesis.Parse([]byte(exportedGenesis))
+	require.NoError(t, err, "unexpected error parsing genesis")
+
+	require.NoError(t, fork.ResetValidatorSet(genesisState), "unexpected error resetting validator set")
+
+	for path, expValue := range map[string]interface{}{
+		"validators":                                 []interface{}{},
+		"app_state.staking.validators":               []interface{}{},
+		"app_state.staking.delegations":              []interface{}{},
+		"app_state.staking.last_total_power":         "0",
+		"app_state.staking.exported":                 false,
+		"app_state.distribution.outstanding_rewards": []interface{}{},
+		"app_state.distribution.previous_proposer":   "",
+		"app_state.slashing.signing_infos":           []interface{}{},
+		"app_state.bank.supply": []interface{}{
+			map[string]interface{}{"denom": "aevmos", "amount": "400"},
+		},
+		"app_state.bank.balances": []interface{}{
+			map[string]interface{}{
+				"address": "evmos1user",
+				"coins":   []interface{}{map[string]interface{}{"denom": "aevmos", "amount": "100"}},
+			},
+			map[string]interface{}{
+				"address": "evmos1distr",
+				"coins":   []interface{}{map[string]interface{}{"denom": "aevmos", "amount": "300"}},
+			},
+		},
+	} {
+		value, err := genesisState.Get(path)
+		require.NoError(t, err, "unexpected error getting %s", path)
+		require.Equal(t, expValue, value, "expected different value for %s", path)
+	}
+
+	_, err = genesisState.Get("app_state.staking.unbonding_delegations")
+	require.Error(t, err, "expected missing fields not to be added")
+	require.NotEqual(t, "2022-04-27T17:00:00Z", genesisState["genesis_time"], "expected genesis time to be updated")
+}
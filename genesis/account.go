@@ -0,0 +1,160 @@
+package genesis
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+)
+
+// emptyCodeHash is the code hash of Ethereum accounts without contract code.
+const emptyCodeHash = "0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"
+
+// amountPattern matches amounts without a denomination.
+var amountPattern = regexp.MustCompile(`^\d+$`)
+
+// AddAccount adds an Ethereum account with the given bech32 address to the auth module
+// and its balance to the balances and the total supply of the bank module.
+// If the amount is given without a denomination, the given denomination is used.
+func (g Genesis) AddAccount(address, amount, denom string) error {
+	if amountPattern.MatchString(amount) {
+		amount += denom
+	}
+
+	coins, err := sdk.ParseCoinsNormalized(amount)
+	if err != nil || coins.Empty() {
+		return fmt.Errorf("invalid amount %q", amount)
+	}
+
+	accounts, err := g.getList("app_state.auth.accounts")
+	if err != nil {
+		return err
+	}
+
+	for _, acc := range accounts {
+		if getAccountAddress(acc) == address {
+			return fmt.Errorf("account %s already exists in genesis", address)
+		}
+	}
+
+	balances, err := g.getList("app_state.bank.balances")
+	if err != nil {
+		return err
+	}
+
+	supplyValue, err := g.Get("app_state.bank.supply")
+	if err != nil {
+		return err
+	}
+
+	supply, err := decodeCoins(supplyValue)
+	if err != nil {
+		return errors.Wrap(err, "invalid total supply")
+	}
+
+	accounts = append(accounts, map[string]interface{}{
+		"@type": "/ethermint.types.v1.EthAccount",
+		"base_account": map[string]interface{}{
+			"address":        address,
+			"pub_key":        nil,
+			"account_number": "0",
+			"sequence":       "0",
+		},
+		"code_hash": emptyCodeHash,
+	})
+
+	balances = append(balances, map[string]interface{}{"address": address, "coins": encodeCoins(coins)})
+
+	for path, value := range map[string]interface{}{
+		"app_state.auth.accounts": accounts,
+		"app_state.bank.balances": balances,
+		"app_state.bank.supply":   encodeCoins(supply.Add(coins...)),
+	} {
+		if err = g.Set(path, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateSupply sets the total supply of the bank module to the sum of all balances.
+func (g Genesis) UpdateSupply() error {
+	balances, err := g.getList("app_state.bank.balances")
+	if err != nil {
+		return err
+	}
+
+	supply := sdk.NewCoins()
+
+	for _, balance := range balances {
+		fields, ok := balance.(map[string]interface{})
+		if !ok {
+			return errors.New("unexpected format of the bank balances")
+		}
+
+		coins, err := decodeCoins(fields["coins"])
+		if err != nil {
+			return errors.Wrapf(err, "invalid balance of %v", fields["address"])
+		}
+
+		supply = supply.Add(coins...)
+	}
+
+	return g.Set("app_state.bank.supply", encodeCoins(supply))
+}
+
+// getList returns the list at the given path. A missing or null list is returned as an empty list.
+func (g Genesis) getList(path string) ([]interface{}, error) {
+	value, err := g.Get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if value == nil {
+		return []interface{}{}, nil
+	}
+
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("genesis field %s is not a list", path)
+	}
+
+	return list, nil
+}
+
+// getAccountAddress returns the address of the given genesis account, which is either
+// a base account or an account type embedding the base account.
+func getAccountAddress(acc interface{}) string {
+	fields, ok := acc.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+
+	if address, ok := fields["address"].(string); ok {
+		return address
+	}
+
+	return getAccountAddress(fields["base_account"])
+}
+
+// decodeCoins decodes the given genesis representation of coins.
+func decodeCoins(value interface{}) (sdk.Coins, error) {
+	if value == nil {
+		return sdk.Coins{}, nil
+	}
+
+	bz, err := json.Marshal(value)
+	if err != nil {
+		return nil, errors.Wrap(err, "error marshalling coins")
+	}
+
+	var coins sdk.Coins
+	if err = json.Unmarshal(bz, &coins); err != nil {
+		return nil, errors.Wrap(err, "error unmarshalling coins")
+	}
+
+	return coins, nil
+}
@@ -0,0 +1,106 @@
+package genesis_test
+
+import (
+	"testing"
+
+	"github.com/MalteHerrmann/evmos-utils/genesis"
+	"github.com/stretchr/testify/require"
+)
+
+//nolint:funlen // function length is okay for tests
+func TestAddAccount(t *testing.T) {
+	t.Parallel()
+
+	address := "evmos1vdyc8y8d7n5zjstmmnwwnpsqtsyz52pc42rs54"
+	existing := "evmos1qqqsyqcyq5rqwzqfpg9scrgwpugpzysn7yvwrq"
+
+	testcases := []struct {
+		name        string
+		amount      string
+		expSupply   []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			name:   "pass - amount without denomination",
+			amount: "1000",
+			expSupply: []interface{}{
+				map[string]interface{}{"denom": "aevmos", "amount": "1500"},
+			},
+		},
+		{
+			name:   "pass - multiple denominations",
+			amount: "1000aevmos,10uatom",
+			expSupply: []interface{}{
+				map[string]interface{}{"denom": "aevmos", "amount": "1500"},
+				map[string]interface{}{"denom": "uatom", "amount": "10"},
+			},
+		},
+		{
+			name:        "fail - invalid amount",
+			amount:      "abc",
+			expError:    true,
+			errContains: "invalid amount",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			genesisState, err := genesis.Parse([]byte(`{"app_state": {
+  "auth": {"accounts": [{"@type": "/ethermint.types.v1.EthAccount", "base_account": {"address": "` + existing + `"}}]},
+  "bank": {
+    "balances": [{"address": "` + existing + `", "coins": [{"denom": "aevmos", "amount": "500"}]}],
+    "supply": [{"denom": "aevmos", "amount": "500"}]
+  }
+}}`))
+			require.NoError(t, err, "unexpected error parsing genesis")
+
+			err = genesisState.AddAccount(address, tc.amount, "aevmos")
+			if tc.expError {
+				require.ErrorContains(t, err, tc.errContains, "expected different error")
+
+				return
+			}
+
+			require.NoError(t, err, "unexpected error adding account")
+
+			accountAddress, err := genesisState.Get("app_state.auth.accounts.1.base_account.address")
+			require.NoError(t, err, "expected account to be added")
+			require.Equal(t, address, accountAddress, "expected different account address")
+
+			balanceAddress, err := genesisState.Get("app_state.bank.balances.1.address")
+			require.NoError(t, err, "expected balance to be added")
+			require.Equal(t, address, balanceAddress, "expected different balance address")
+
+			supply, err := genesisState.Get("app_state.bank.supply")
+			require.NoError(t, err, "unexpected error getting supply")
+			require.Equal(t, tc.expSupply, supply, "expected different supply")
+
+			err = genesisState.AddAccount(address, tc.amount, "aevmos")
+			require.ErrorContains(t, err, "already exists", "expected error adding the account twice")
+		})
+	}
+}
+
+func TestUpdateSupply(t *testing.T) {
+	t.Parallel()
+
+	genesisState, err := genesis.Parse([]byte(`{"app_state": {"bank": {
+  "balances": [
+    {"address": "evmos1a", "coins": [{"denom": "aevmos", "amount": "500"}]},
+    {"address": "evmos1b", "coins": [{"denom": "aevmos", "amount": "20"}, {"denom": "ibc/ABC", "amount": "3"}]}
+  ],
+  "supply": []
+}}}`))
+	require.NoError(t, err, "unexpected error parsing genesis")
+	require.NoError(t, genesisState.UpdateSupply(), "unexpected error updating supply")
+
+	supply, err := genesisState.Get("app_state.bank.supply")
+	require.NoError(t, err, "unexpected error getting supply")
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"denom": "aevmos", "amount": "520"},
+		map[string]interface{}{"denom": "ibc/ABC", "amount": "3"},
+	}, supply, "expected supply to be the sum of all balances")
+}
@@ -0,0 +1,159 @@
+package genesis
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Genesis is the generic representation of a genesis file, that allows
+// to edit arbitrary fields addressed by their path.
+type Genesis map[string]interface{}
+
+// GetGenesisPath returns the path of the genesis file in the given home directory.
+func GetGenesisPath(home string) string {
+	return filepath.Join(home, "config", "genesis.json")
+}
+
+// Load reads the genesis file at the given path.
+func Load(path string) (Genesis, error) {
+	//#nosec G304 // path is built from the configured home directory
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading genesis file")
+	}
+
+	return Parse(bz)
+}
+
+// Parse parses the given genesis contents. Numbers are kept as json.Number,
+// so that large integers do not lose precision.
+func Parse(bz []byte) (Genesis, error) {
+	var genesis Genesis
+
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&genesis); err != nil {
+		return nil, errors.Wrap(err, "error unmarshalling genesis file")
+	}
+
+	return genesis, nil
+}
+
+// Save writes the genesis to the file at the given path.
+func (g Genesis) Save(path string) error {
+	bz, err := json.MarshalIndent(g, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "error marshalling genesis")
+	}
+
+	if err = os.WriteFile(path, bz, 0o600); err != nil {
+		return errors.Wrap(err, "error writing genesis file")
+	}
+
+	return nil
+}
+
+// Get returns the value at the given path, where the keys are separated by dots
+// and array elements are addressed by their index, e.g. "app_state.gov.params.min_deposit.0.amount".
+func (g Genesis) Get(path string) (interface{}, error) {
+	var current interface{} = map[string]interface{}(g)
+
+	for _, key := range strings.Split(path, ".") {
+		next, err := getChild(current, key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid path %q", path)
+		}
+
+		current = next
+	}
+
+	return current, nil
+}
+
+// Set sets the value at the given path. All elements of the path except for
+// the last one have to exist already.
+func (g Genesis) Set(path string, value interface{}) error {
+	keys := strings.Split(path, ".")
+
+	var parent interface{} = map[string]interface{}(g)
+
+	if len(keys) > 1 {
+		var err error
+
+		if parent, err = g.Get(strings.Join(keys[:len(keys)-1], ".")); err != nil {
+			return err
+		}
+	}
+
+	lastKey := keys[len(keys)-1]
+
+	switch typedParent := parent.(type) {
+	case map[string]interface{}:
+		typedParent[lastKey] = value
+	case []interface{}:
+		index, err := parseIndex(lastKey, len(typedParent))
+		if err != nil {
+			return errors.Wrapf(err, "invalid path %q", path)
+		}
+
+		typedParent[index] = value
+	default:
+		return fmt.Errorf("invalid path %q: parent is neither an object nor an array", path)
+	}
+
+	return nil
+}
+
+// SetIfExists sets the value at the given path only if the path already exists.
+// It returns whether the value was set.
+func (g Genesis) SetIfExists(path string, value interface{}) bool {
+	if current, err := g.Get(path); err != nil || current == nil {
+		return false
+	}
+
+	return g.Set(path, value) == nil
+}
+
+// getChild returns the child of the given object or array with the given key.
+func getChild(parent interface{}, key string) (interface{}, error) {
+	switch typedParent := parent.(type) {
+	case map[string]interface{}:
+		child, found := typedParent[key]
+		if !found {
+			return nil, fmt.Errorf("key %q not found", key)
+		}
+
+		return child, nil
+	case []interface{}:
+		index, err := parseIndex(key, len(typedParent))
+		if err != nil {
+			return nil, err
+		}
+
+		return typedParent[index], nil
+	default:
+		return nil, fmt.Errorf("cannot access key %q of a value that is neither an object nor an array", key)
+	}
+}
+
+// parseIndex parses the given key as an index of an array with the given length.
+func parseIndex(key string, length int) (int, error) {
+	index, err := strconv.Atoi(key)
+	if err != nil {
+		return 0, fmt.Errorf("invalid array index %q", key)
+	}
+
+	if index < 0 || index >= length {
+		return 0, fmt.Errorf("array index %d out of range", index)
+	}
+
+	return index, nil
+}
@@ -0,0 +1,132 @@
+package genesis_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/MalteHerrmann/evmos-utils/genesis"
+	"github.com/stretchr/testify/require"
+)
+
+const testGenesis = `{
+  "chain_id": "evmos_9000-1",
+  "app_state": {
+    "gov": {
+      "params": {
+        "min_deposit": [{"denom": "stake", "amount": "10000000"}],
+        "voting_period": "172800s"
+      },
+      "voting_params": null
+    }
+  }
+}`
+
+func getTestGenesis(t *testing.T) genesis.Genesis {
+	t.Helper()
+
+	var genesisState genesis.Genesis
+
+	require.NoError(t, json.Unmarshal([]byte(testGenesis), &genesisState), "unexpected error unmarshalling genesis")
+
+	return genesisState
+}
+
+func TestSet(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		path        string
+		value       interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			name:  "pass - top level field",
+			path:  "chain_id",
+			value: "evmos_9001-2",
+		},
+		{
+			name:  "pass - nested field",
+			path:  "app_state.gov.params.voting_period",
+			value: "30s",
+		},
+		{
+			name:  "pass - array element",
+			path:  "app_state.gov.params.min_deposit.0.denom",
+			value: "aevmos",
+		},
+		{
+			name:        "fail - missing parent",
+			path:        "app_state.evm.params.evm_denom",
+			value:       "aevmos",
+			expError:    true,
+			errContains: `key "evm" not found`,
+		},
+		{
+			name:        "fail - index out of range",
+			path:        "app_state.gov.params.min_deposit.1",
+			value:       "aevmos",
+			expError:    true,
+			errContains: "array index 1 out of range",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			genesisState := getTestGenesis(t)
+
+			err := genesisState.Set(tc.path, tc.value)
+			if tc.expError {
+				require.Error(t, err, "expected error setting value")
+				require.ErrorContains(t, err, tc.errContains, "expected different error")
+			} else {
+				require.NoError(t, err, "unexpected error setting value")
+
+				value, err := genesisState.Get(tc.path)
+				require.NoError(t, err, "unexpected error getting value")
+				require.Equal(t, tc.value, value, "expected different value")
+			}
+		})
+	}
+}
+
+func TestSetIfExists(t *testing.T) {
+	t.Parallel()
+
+	genesisState := getTestGenesis(t)
+
+	require.True(t,
+		genesisState.SetIfExists("app_state.gov.params.voting_period", "30s"),
+		"expected value to be set",
+	)
+	require.False(t,
+		genesisState.SetIfExists("app_state.gov.voting_params.voting_period", "30s"),
+		"expected null value to be skipped",
+	)
+	require.False(t,
+		genesisState.SetIfExists("app_state.evm.params.evm_denom", "aevmos"),
+		"expected missing path to be skipped",
+	)
+}
+
+func TestLoadKeepsLargeNumbers(t *testing.T) {
+	t.Parallel()
+
+	// NOTE: 2^53 + 1 cannot be represented as a float64
+	path := filepath.Join(t.TempDir(), "genesis.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"initial_height":9007199254740993}`), 0o600),
+		"unexpected error writing genesis")
+
+	genesisState, err := genesis.Load(path)
+	require.NoError(t, err, "unexpected error loading genesis")
+	require.NoError(t, genesisState.Save(path), "unexpected error saving genesis")
+
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err, "unexpected error reading genesis")
+	require.Contains(t, string(bz), `"initial_height": 9007199254740993`, "expected number to keep its precision")
+}
@@ -0,0 +1,158 @@
+package genesis
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+)
+
+// durationPattern matches durations as they are encoded in the genesis, e.g. "172800s".
+var durationPattern = regexp.MustCompile(`^\d+(\.\d+)?s$`)
+
+// ResolvePath resolves the given shorthand path to the full path of an existing genesis field.
+// Paths starting with the name of a module are resolved relative to the module's application state,
+// and fields of the module parameters can be addressed without the "params" element,
+// e.g. "gov.voting_period" resolves to "app_state.gov.params.voting_period".
+func (g Genesis) ResolvePath(path string) (string, error) {
+	candidates := []string{path}
+
+	if appState, ok := g["app_state"].(map[string]interface{}); ok {
+		module, rest, _ := strings.Cut(path, ".")
+		if _, isModule := appState[module]; isModule {
+			candidates = append(candidates, "app_state."+path)
+			if rest != "" {
+				candidates = append(candidates, fmt.Sprintf("app_state.%s.params.%s", module, rest))
+			}
+		}
+	}
+
+	for _, candidate := range candidates {
+		if _, err := g.Get(candidate); err == nil {
+			return candidate, nil
+		}
+	}
+
+	return "", fmt.Errorf("genesis field %q not found", path)
+}
+
+// SetTyped parses the given raw value according to the type of the existing field at the given
+// shorthand path and sets it. It returns the resolved full path of the field.
+func (g Genesis) SetTyped(path, rawValue string) (string, error) {
+	fullPath, err := g.ResolvePath(path)
+	if err != nil {
+		return "", err
+	}
+
+	current, err := g.Get(fullPath)
+	if err != nil {
+		return "", err
+	}
+
+	value, err := ParseValue(current, rawValue)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid value for %s", fullPath)
+	}
+
+	return fullPath, g.Set(fullPath, value)
+}
+
+// ParseValue parses the given raw value into the type of the given existing value.
+// Durations (e.g. "30s" or "5m") are converted to the genesis format in seconds,
+// coins (e.g. "1000aevmos") are converted to a list of denominations and amounts.
+func ParseValue(current interface{}, rawValue string) (interface{}, error) {
+	switch typedCurrent := current.(type) {
+	case bool:
+		return strconv.ParseBool(rawValue)
+	case json.Number:
+		if _, err := strconv.ParseFloat(rawValue, 64); err != nil {
+			return nil, fmt.Errorf("expected a number; got %q", rawValue)
+		}
+
+		return json.Number(rawValue), nil
+	case string:
+		if durationPattern.MatchString(typedCurrent) {
+			duration, err := time.ParseDuration(rawValue)
+			if err != nil {
+				return nil, fmt.Errorf("expected a duration; got %q", rawValue)
+			}
+
+			return strconv.FormatFloat(duration.Seconds(), 'f', -1, 64) + "s", nil
+		}
+
+		return rawValue, nil
+	case []interface{}:
+		if isCoinList(typedCurrent) {
+			return parseCoins(rawValue)
+		}
+
+		return parseJSON(rawValue)
+	case map[string]interface{}:
+		return parseJSON(rawValue)
+	default:
+		if value, err := parseJSON(rawValue); err == nil {
+			return value, nil
+		}
+
+		return rawValue, nil
+	}
+}
+
+// isCoinList checks if the given list contains coins, i.e. objects with a denomination and an amount.
+func isCoinList(list []interface{}) bool {
+	if len(list) == 0 {
+		return false
+	}
+
+	for _, element := range list {
+		coin, ok := element.(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		if _, hasDenom := coin["denom"]; !hasDenom {
+			return false
+		}
+
+		if _, hasAmount := coin["amount"]; !hasAmount {
+			return false
+		}
+	}
+
+	return true
+}
+
+// parseCoins parses the given coins string into the genesis representation of coins.
+func parseCoins(rawValue string) (interface{}, error) {
+	coins, err := sdk.ParseCoinsNormalized(rawValue)
+	if err != nil {
+		return nil, fmt.Errorf("expected coins; got %q", rawValue)
+	}
+
+	return encodeCoins(coins), nil
+}
+
+// encodeCoins returns the genesis representation of the given coins.
+func encodeCoins(coins sdk.Coins) []interface{} {
+	list := make([]interface{}, 0, len(coins))
+	for _, coin := range coins {
+		list = append(list, map[string]interface{}{"denom": coin.Denom, "amount": coin.Amount.String()})
+	}
+
+	return list
+}
+
+// parseJSON parses the given raw value as JSON.
+func parseJSON(rawValue string) (interface{}, error) {
+	var value interface{}
+	if err := json.Unmarshal([]byte(rawValue), &value); err != nil {
+		return nil, fmt.Errorf("expected a JSON value; got %q", rawValue)
+	}
+
+	return value, nil
+}
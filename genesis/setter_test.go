@@ -0,0 +1,224 @@
+package genesis_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/MalteHerrmann/evmos-utils/genesis"
+	"github.com/stretchr/testify/require"
+)
+
+const testModuleGenesis = `{
+  "chain_id": "evmos_9000-1",
+  "app_state": {
+    "evm": {
+      "params": {
+        "allow_unprotected_txs": false,
+        "evm_denom": "aevmos"
+      }
+    },
+    "gov": {
+      "params": {
+        "min_deposit": [{"denom": "aevmos", "amount": "10000000"}],
+        "voting_period": "172800s",
+        "quorum": "0.334000000000000000"
+      },
+      "starting_proposal_id": "1"
+    },
+    "staking": {
+      "params": {
+        "max_validators": 100
+      }
+    }
+  }
+}`
+
+func TestResolvePath(t *testing.T) {
+	t.Parallel()
+
+	genesisState, err := genesis.Parse([]byte(testModuleGenesis))
+	require.NoError(t, err, "unexpected error parsing genesis")
+
+	testcases := []struct {
+		name     string
+		path     string
+		expPath  string
+		expError bool
+	}{
+		{
+			name:    "pass - top level field",
+			path:    "chain_id",
+			expPath: "chain_id",
+		},
+		{
+			name:    "pass - full path",
+			path:    "app_state.gov.params.voting_period",
+			expPath: "app_state.gov.params.voting_period",
+		},
+		{
+			name:    "pass - module field",
+			path:    "gov.starting_proposal_id",
+			expPath: "app_state.gov.starting_proposal_id",
+		},
+		{
+			name:    "pass - module parameter without params",
+			path:    "gov.voting_period",
+			expPath: "app_state.gov.params.voting_period",
+		},
+		{
+			name:    "pass - module parameter with params",
+			path:    "evm.params.allow_unprotected_txs",
+			expPath: "app_state.evm.params.allow_unprotected_txs",
+		},
+		{
+			name:     "fail - unknown field",
+			path:     "gov.unknown",
+			expError: true,
+		},
+		{
+			name:     "fail - unknown module",
+			path:     "erc20.params.enable_erc20",
+			expError: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			path, err := genesisState.ResolvePath(tc.path)
+			if tc.expError {
+				require.Error(t, err, "expected error resolving path")
+			} else {
+				require.NoError(t, err, "unexpected error resolving path")
+				require.Equal(t, tc.expPath, path, "expected different path")
+			}
+		})
+	}
+}
+
+func TestParseValue(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		current  interface{}
+		raw      string
+		expValue interface{}
+		expError bool
+	}{
+		{
+			name:     "pass - bool",
+			current:  false,
+			raw:      "true",
+			expValue: true,
+		},
+		{
+			name:     "fail - invalid bool",
+			current:  false,
+			raw:      "yes please",
+			expError: true,
+		},
+		{
+			name:     "pass - number",
+			current:  json.Number("100"),
+			raw:      "50",
+			expValue: json.Number("50"),
+		},
+		{
+			name:     "fail - invalid number",
+			current:  json.Number("100"),
+			raw:      "fifty",
+			expError: true,
+		},
+		{
+			name:     "pass - duration",
+			current:  "172800s",
+			raw:      "5m",
+			expValue: "300s",
+		},
+		{
+			name:     "fail - invalid duration",
+			current:  "172800s",
+			raw:      "soon",
+			expError: true,
+		},
+		{
+			name:     "pass - string",
+			current:  "0.334000000000000000",
+			raw:      "0.5",
+			expValue: "0.5",
+		},
+		{
+			name:    "pass - coins",
+			current: []interface{}{map[string]interface{}{"denom": "aevmos", "amount": "10000000"}},
+			raw:     "1000aevmos,5atest",
+			expValue: []interface{}{
+				map[string]interface{}{"denom": "aevmos", "amount": "1000"},
+				map[string]interface{}{"denom": "atest", "amount": "5"},
+			},
+		},
+		{
+			name:     "fail - invalid coins",
+			current:  []interface{}{map[string]interface{}{"denom": "aevmos", "amount": "10000000"}},
+			raw:      "many coins",
+			expError: true,
+		},
+		{
+			name:     "pass - list",
+			current:  []interface{}{"a"},
+			raw:      `["b", "c"]`,
+			expValue: []interface{}{"b", "c"},
+		},
+		{
+			name:     "pass - object",
+			current:  map[string]interface{}{},
+			raw:      `{"key": "value"}`,
+			expValue: map[string]interface{}{"key": "value"},
+		},
+		{
+			name:     "fail - invalid object",
+			current:  map[string]interface{}{},
+			raw:      "key=value",
+			expError: true,
+		},
+		{
+			name:     "pass - null",
+			current:  nil,
+			raw:      "plain",
+			expValue: "plain",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			value, err := genesis.ParseValue(tc.current, tc.raw)
+			if tc.expError {
+				require.Error(t, err, "expected error parsing value")
+			} else {
+				require.NoError(t, err, "unexpected error parsing value")
+				require.Equal(t, tc.expValue, value, "expected different value")
+			}
+		})
+	}
+}
+
+func TestSetTyped(t *testing.T) {
+	t.Parallel()
+
+	genesisState, err := genesis.Parse([]byte(testModuleGenesis))
+	require.NoError(t, err, "unexpected error parsing genesis")
+
+	path, err := genesisState.SetTyped("gov.voting_period", "30s")
+	require.NoError(t, err, "unexpected error setting value")
+	require.Equal(t, "app_state.gov.params.voting_period", path, "expected different path")
+
+	value, err := genesisState.Get(path)
+	require.NoError(t, err, "unexpected error getting value")
+	require.Equal(t, "30s", value, "expected different value")
+
+	_, err = genesisState.SetTyped("evm.params.allow_unprotected_txs", "maybe")
+	require.Error(t, err, "expected error setting invalid bool")
+}
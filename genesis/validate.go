@@ -0,0 +1,39 @@
+package genesis
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/evmos/evmos/v17/app"
+	"github.com/evmos/evmos/v17/encoding"
+	"github.com/pkg/errors"
+)
+
+// Validate validates the application state of the genesis using the genesis validation
+// of all modules of the Evmos app. Modules, that are missing in the genesis,
+// are validated using their default genesis.
+func (g Genesis) Validate(cdc *codec.ProtoCodec) error {
+	bz, err := json.Marshal(g["app_state"])
+	if err != nil {
+		return errors.Wrap(err, "error marshalling application state")
+	}
+
+	var appState map[string]json.RawMessage
+	if err = json.Unmarshal(bz, &appState); err != nil {
+		return errors.Wrap(err, "genesis does not contain a valid application state")
+	}
+
+	for module, defaultState := range app.ModuleBasics.DefaultGenesis(cdc) {
+		if _, found := appState[module]; !found {
+			appState[module] = defaultState
+		}
+	}
+
+	encodingConfig := encoding.MakeConfig(app.ModuleBasics)
+
+	if err = app.ModuleBasics.ValidateGenesis(cdc, encodingConfig.TxConfig, appState); err != nil {
+		return errors.Wrap(err, "invalid genesis")
+	}
+
+	return nil
+}
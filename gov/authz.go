@@ -0,0 +1,246 @@
+package gov
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/pkg/errors"
+)
+
+// GrantVotes grants the given grantee the authorization to vote on behalf of all selected accounts
+// with delegations, using a generic authorization for MsgVote.
+func GrantVotes(bin *utils.Binary, grantee string) error {
+	granteeAddress, err := bin.ResolveAddress(grantee)
+	if err != nil {
+		return errors.Wrap(err, "error resolving grantee")
+	}
+
+	accsWithDelegations, err := utils.FilterAccountsWithDelegations(bin)
+	if err != nil {
+		return errors.Wrap(err, "error filtering accounts")
+	}
+
+	var granted, failed int
+
+	for _, acc := range accsWithDelegations {
+		if acc.Address == granteeAddress {
+			continue
+		}
+
+		out, err := utils.ExecuteTx(bin, utils.TxArgs{
+			Subcommand: []string{
+				"tx", "authz", "grant", granteeAddress, "generic",
+				"--msg-type", sdk.MsgTypeURL(&govv1.MsgVote{}),
+				"--output", "json",
+			},
+			From:  acc.Name,
+			Quiet: true,
+		})
+		if err == nil && out != "" {
+			_, err = utils.GetTxHashFromTxResponse(bin.Cdc, out)
+		}
+
+		if err != nil {
+			bin.Logger.Error().Msgf("could not grant vote authorization using key %s: %v: %s",
+				acc.Name, err, strings.TrimSpace(out))
+
+			failed++
+
+			continue
+		}
+
+		bin.Logger.Info().Msgf("granted vote authorization to %s using key %s", granteeAddress, acc.Name)
+
+		granted++
+	}
+
+	if granted == 0 && failed == 0 {
+		return errors.New("no accounts with delegations found")
+	}
+
+	if granted == 0 {
+		return errors.New("could not grant vote authorization using any key, please check logs")
+	}
+
+	return nil
+}
+
+// SubmitAllVotesViaAuthz submits a vote for the given proposal ID on behalf of all accounts,
+// that granted the given grantee the authorization to vote. The granters are queried on chain,
+// so they do not have to be part of the keyring. Each vote is wrapped in a MsgExec, which is sent by the grantee.
+func SubmitAllVotesViaAuthz(bin *utils.Binary, args []string, grantee string) (int, error) {
+	proposalID, err := GetProposalIDFromInput(bin, args)
+	if err != nil {
+		return 0, err
+	}
+
+	granteeAcc, err := utils.FindAccount(bin.Accounts, grantee)
+	if err != nil {
+		return 0, errors.Wrap(err, "error finding grantee")
+	}
+
+	granters, err := QueryVoteGranters(bin, granteeAcc.Address)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(granters) == 0 {
+		return 0, fmt.Errorf("no vote authorizations granted to %s found", granteeAcc.Name)
+	}
+
+	voters := make([]utils.Account, 0, len(granters))
+
+	for _, granter := range granters {
+		// NOTE: granters, that are not in the keyring, are logged with their address
+		voter, findErr := utils.FindAccount(bin.Accounts, granter)
+		if findErr != nil {
+			voter = utils.Account{Name: granter, Address: granter}
+		}
+
+		voters = append(voters, voter)
+	}
+
+	return proposalID, castVotes(bin, proposalID, voters, nil, func(acc utils.Account) (string, error) {
+		return VoteForProposalViaAuthz(bin, proposalID, acc, granteeAcc.Name)
+	})
+}
+
+// QueryVoteGranters returns the addresses of the accounts, that granted the given grantee
+// the authorization to vote.
+func QueryVoteGranters(bin *utils.Binary, grantee string) ([]string, error) {
+	out, err := utils.ExecuteQuery(bin, utils.QueryArgs{
+		Subcommand: []string{"q", "authz", "grants-by-grantee", grantee, "--output=json"},
+		Quiet:      true,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error querying grants of %s: %s", grantee, out)
+	}
+
+	return ParseVoteGranters(out, time.Now())
+}
+
+// ParseVoteGranters returns the granters of the generic vote authorizations from the given output
+// of the grants by grantee query. Grants, that are expired at the given time, are skipped.
+func ParseVoteGranters(out string, now time.Time) ([]string, error) {
+	var res struct {
+		Grants []struct {
+			Granter       string `json:"granter"`
+			Authorization struct {
+				Type string `json:"@type"`
+				Msg  string `json:"msg"`
+			} `json:"authorization"`
+			Expiration *time.Time `json:"expiration"`
+		} `json:"grants"`
+	}
+
+	if err := json.Unmarshal([]byte(out), &res); err != nil {
+		return nil, fmt.Errorf("error unmarshalling grants: %w", err)
+	}
+
+	voteMsgType := sdk.MsgTypeURL(&govv1.MsgVote{})
+	genericAuthzType := "/" + proto.MessageName(&authz.GenericAuthorization{})
+
+	granters := make([]string, 0, len(res.Grants))
+
+	for _, grant := range res.Grants {
+		if grant.Authorization.Type != genericAuthzType || grant.Authorization.Msg != voteMsgType {
+			continue
+		}
+
+		if grant.Expiration != nil && !grant.Expiration.After(now) {
+			continue
+		}
+
+		granters = append(granters, grant.Granter)
+	}
+
+	return granters, nil
+}
+
+// VoteForProposalViaAuthz votes for the proposal with the given ID on behalf of the given voter,
+// by executing the vote with the given grantee key.
+//
+// The grantee sends one transaction per vote, so this waits for the transaction
+// to be included in a block before returning, to avoid account sequence mismatches.
+func VoteForProposalViaAuthz(bin *utils.Binary, proposalID int, voter utils.Account, grantee string) (string, error) {
+	bz, err := BuildVoteTx(bin.Cdc, proposalID, voter.Address)
+	if err != nil {
+		return "", err
+	}
+
+	txFile, err := os.CreateTemp("", fmt.Sprintf("vote-%d-%s-*.json", proposalID, voter.Name))
+	if err != nil {
+		return "", errors.Wrap(err, "error creating transaction file")
+	}
+
+	// the planned transactions of a dry run refer to the file, so it is only removed afterwards otherwise
+	if !bin.Config.DryRun {
+		defer func() {
+			if removeErr := os.Remove(txFile.Name()); removeErr != nil {
+				bin.Logger.Error().Msgf("error removing transaction file: %s", removeErr)
+			}
+		}()
+	}
+
+	_, err = txFile.Write(bz)
+	if closeErr := txFile.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return "", errors.Wrap(err, "error writing transaction file")
+	}
+
+	out, err := utils.ExecuteTx(bin, utils.TxArgs{
+		Subcommand: []string{"tx", "authz", "exec", txFile.Name(), "--output", "json"},
+		From:       grantee,
+		Quiet:      true,
+	})
+	if err != nil {
+		return out, errors.Wrap(err, fmt.Sprintf("failed to vote for proposal %d via authz", proposalID))
+	}
+
+	if bin.Config.DryRun {
+		return out, nil
+	}
+
+	if _, err = utils.GetTxEvents(bin, out); err != nil {
+		return out, errors.Wrap(err, "error waiting for vote transaction")
+	}
+
+	return out, nil
+}
+
+// BuildVoteTx returns the JSON encoded unsigned transaction containing a yes vote
+// of the given voter for the proposal with the given ID, as used with `tx authz exec`.
+func BuildVoteTx(cdc *codec.ProtoCodec, proposalID int, voter string) ([]byte, error) {
+	msg, err := codectypes.NewAnyWithValue(&govv1.MsgVote{
+		ProposalId: uint64(proposalID), //#nosec G115 // proposal IDs are never negative
+		Voter:      voter,
+		Option:     govv1.OptionYes,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error packing vote message")
+	}
+
+	bz, err := cdc.MarshalJSON(&txtypes.Tx{
+		Body:     &txtypes.TxBody{Messages: []*codectypes.Any{msg}},
+		AuthInfo: &txtypes.AuthInfo{Fee: &txtypes.Fee{}},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error encoding vote transaction")
+	}
+
+	return bz, nil
+}
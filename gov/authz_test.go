@@ -0,0 +1,100 @@
+package gov_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MalteHerrmann/evmos-utils/gov"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildVoteTx(t *testing.T) {
+	t.Parallel()
+
+	cdc, ok := utils.GetCodec()
+	require.True(t, ok, "expected codec to be a proto codec")
+
+	voter := "evmos1vdyc8y8d7n5zjstmmnwwnpsqtsyz52pc42rs54"
+
+	bz, err := gov.BuildVoteTx(cdc, 7, voter)
+	require.NoError(t, err, "expected no error building vote transaction")
+
+	var tx txtypes.Tx
+	require.NoError(t, cdc.UnmarshalJSON(bz, &tx), "expected no error decoding vote transaction")
+	require.NoError(t, tx.UnpackInterfaces(cdc.InterfaceRegistry()), "expected no error unpacking messages")
+
+	msgs := tx.GetMsgs()
+	require.Len(t, msgs, 1, "expected one message")
+	require.Equal(t, &govv1.MsgVote{
+		ProposalId: 7,
+		Voter:      voter,
+		Option:     govv1.OptionYes,
+	}, msgs[0], "expected different vote message")
+}
+
+func TestParseVoteGranters(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testcases := []struct {
+		name        string
+		out         string
+		expGranters []string
+		expError    bool
+		errContains string
+	}{
+		{
+			name: "pass - vote authorizations",
+			out: `{"grants":[
+				{"granter":"evmos1a","grantee":"evmos1g","authorization":{
+					"@type":"/cosmos.authz.v1beta1.GenericAuthorization","msg":"/cosmos.gov.v1.MsgVote"
+				},"expiration":null},
+				{"granter":"evmos1b","grantee":"evmos1g","authorization":{
+					"@type":"/cosmos.authz.v1beta1.GenericAuthorization","msg":"/cosmos.gov.v1.MsgVote"
+				},"expiration":"2025-01-01T00:00:00Z"}
+			],"pagination":{"next_key":null,"total":"0"}}`,
+			expGranters: []string{"evmos1a", "evmos1b"},
+		},
+		{
+			name: "pass - skip other and expired authorizations",
+			out: `{"grants":[
+				{"granter":"evmos1a","grantee":"evmos1g","authorization":{
+					"@type":"/cosmos.authz.v1beta1.GenericAuthorization","msg":"/cosmos.bank.v1beta1.MsgSend"
+				}},
+				{"granter":"evmos1b","grantee":"evmos1g","authorization":{
+					"@type":"/cosmos.authz.v1beta1.GenericAuthorization","msg":"/cosmos.gov.v1.MsgVote"
+				},"expiration":"2023-01-01T00:00:00Z"},
+				{"granter":"evmos1c","grantee":"evmos1g","authorization":{
+					"@type":"/cosmos.bank.v1beta1.SendAuthorization","spend_limit":[]
+				}}
+			]}`,
+			expGranters: []string{},
+		},
+		{
+			name:        "fail - invalid output",
+			out:         "invalid",
+			expError:    true,
+			errContains: "error unmarshalling grants",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			granters, err := gov.ParseVoteGranters(tc.out, now)
+			if tc.expError {
+				require.ErrorContains(t, err, tc.errContains, "expected different error")
+
+				return
+			}
+
+			require.NoError(t, err, "expected no error parsing granters")
+			require.Equal(t, tc.expGranters, granters, "expected different granters")
+		})
+	}
+}
@@ -22,26 +22,41 @@ func Deposit(bin *utils.Binary, args []string) (int, error) {
 		return 0, errors.Wrap(err, "failed to get proposal ID")
 	}
 
-	return proposalID, DepositForProposal(
-		bin, proposalID, bin.Accounts[0].Name, deposit.String(),
-	)
+	sender, err := bin.GetSender()
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = DepositForProposal(bin, proposalID, sender.Name, deposit.String())
+
+	return proposalID, err
 }
 
 // DepositForProposal deposits the given amount for the proposal with the given proposalID
-// from the given account.
-func DepositForProposal(bin *utils.Binary, proposalID int, sender, deposit string) error {
-	_, err := utils.ExecuteTx(bin, utils.TxArgs{
+// from the given account. It returns the hash of the deposit transaction,
+// which is empty in dry-run mode.
+func DepositForProposal(bin *utils.Binary, proposalID int, sender, deposit string) (string, error) {
+	out, err := utils.ExecuteTx(bin, utils.TxArgs{
 		Subcommand: []string{
-			"tx", "gov", "deposit", strconv.Itoa(proposalID), deposit,
+			"tx", "gov", "deposit", strconv.Itoa(proposalID), deposit, "--output", "json",
 		},
 		From:  sender,
 		Quiet: true,
 	})
 	if err != nil {
-		return errors.Wrap(err, fmt.Sprintf("failed to deposit for proposal %d", proposalID))
+		return "", errors.Wrap(err, fmt.Sprintf("failed to deposit for proposal %d", proposalID))
+	}
+
+	if bin.Config.DryRun {
+		return "", nil
+	}
+
+	txHash, err := utils.GetTxHashFromTxResponse(bin.Cdc, out)
+	if err != nil {
+		return "", errors.Wrap(err, fmt.Sprintf("failed to deposit for proposal %d", proposalID))
 	}
 
-	return nil
+	return txHash, nil
 }
 
 // GetMinDeposit returns the minimum deposit necessary for a proposal from the governance parameters of
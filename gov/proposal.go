@@ -6,8 +6,10 @@ import (
 	"strings"
 
 	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govv1types "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	"github.com/pkg/errors"
 )
 
@@ -76,13 +78,99 @@ func QueryLatestProposalID(bin *utils.Binary) (int, error) {
 	return int(res.Proposals[len(res.Proposals)-1].Id), nil
 }
 
+// FindPendingUpgradeProposal looks for a proposal in the deposit or voting period,
+// which schedules an upgrade with the given plan name. If no such proposal is found,
+// nil is returned.
+func FindPendingUpgradeProposal(bin *utils.Binary, planName string) (*govv1types.Proposal, *upgradetypes.Plan, error) {
+	out, err := utils.ExecuteQuery(bin, utils.QueryArgs{
+		Subcommand: []string{"q", "gov", "proposals", "--output=json"},
+		Quiet:      true,
+	})
+	if err != nil {
+		if strings.Contains(out, "no proposals found") {
+			return nil, nil, nil
+		}
+
+		return nil, nil, errors.Wrap(err, "error querying proposals")
+	}
+
+	return FindPendingUpgradeProposalInResponse(bin.Cdc, out, planName)
+}
+
+// FindPendingUpgradeProposalInResponse looks for a proposal in the deposit or voting period
+// in the given output of the proposals query, which schedules an upgrade with the given plan name.
+// If no such proposal is found, nil is returned.
+func FindPendingUpgradeProposalInResponse(
+	cdc *codec.ProtoCodec, out, planName string,
+) (*govv1types.Proposal, *upgradetypes.Plan, error) {
+	var res govv1types.QueryProposalsResponse
+
+	if err := cdc.UnmarshalJSON([]byte(out), &res); err != nil {
+		return nil, nil, errors.Wrap(err, "error unmarshalling proposals")
+	}
+
+	if err := govv1types.Proposals(res.Proposals).UnpackInterfaces(cdc.InterfaceRegistry()); err != nil {
+		return nil, nil, errors.Wrap(err, "error unpacking proposal messages")
+	}
+
+	for _, proposal := range res.Proposals {
+		if proposal.Status != govv1types.StatusDepositPeriod && proposal.Status != govv1types.StatusVotingPeriod {
+			continue
+		}
+
+		for _, msg := range proposal.Messages {
+			plan := getUpgradePlan(msg.GetCachedValue())
+			if plan != nil && plan.Name == planName {
+				return proposal, plan, nil
+			}
+		}
+	}
+
+	return nil, nil, nil
+}
+
+// getUpgradePlan returns the upgrade plan contained in the given proposal message
+// or nil if the message does not schedule an upgrade.
+func getUpgradePlan(msg interface{}) *upgradetypes.Plan {
+	switch typedMsg := msg.(type) {
+	case *upgradetypes.MsgSoftwareUpgrade:
+		return &typedMsg.Plan
+	case *govv1types.MsgExecLegacyContent:
+		if upgradeProposal, ok := typedMsg.Content.GetCachedValue().(*upgradetypes.SoftwareUpgradeProposal); ok {
+			return &upgradeProposal.Plan
+		}
+	}
+
+	return nil
+}
+
+// PredictNextProposalID returns the ID that the next submitted proposal will have,
+// based on the latest proposal ID on chain.
+func PredictNextProposalID(bin *utils.Binary) (int, error) {
+	latestID, err := QueryLatestProposalID(bin)
+	if err != nil {
+		if strings.Contains(err.Error(), "no proposals found") {
+			return 1, nil
+		}
+
+		return 0, err
+	}
+
+	return latestID + 1, nil
+}
+
 // SubmitUpgradeProposal submits a software upgrade proposal with the given target version and upgrade height.
 func SubmitUpgradeProposal(bin *utils.Binary, targetVersion string, upgradeHeight int) (int, error) {
 	upgradeProposal := buildUpgradeProposalCommand(targetVersion, upgradeHeight)
 
+	sender, err := bin.GetSender()
+	if err != nil {
+		return 0, err
+	}
+
 	out, err := utils.ExecuteTx(bin, utils.TxArgs{
 		Subcommand: upgradeProposal,
-		From:       bin.Accounts[0].Name,
+		From:       sender.Name,
 	})
 	if err != nil {
 		return 0, errors.Wrap(err,
@@ -90,10 +178,10 @@ func SubmitUpgradeProposal(bin *utils.Binary, targetVersion string, upgradeHeigh
 		)
 	}
 
-	// Clean gas estimate output and only leave json output
-	out = strings.TrimSpace(out)
-	lines := strings.Split(out, "\n")
-	out = lines[len(lines)-1] // last line is json output
+	// NOTE: in dry-run mode there is no transaction output, so the next proposal ID is predicted
+	if bin.Config.DryRun {
+		return PredictNextProposalID(bin)
+	}
 
 	events, err := utils.GetTxEvents(bin, out)
 	if err != nil {
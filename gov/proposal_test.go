@@ -1,9 +1,11 @@
 package gov_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/MalteHerrmann/evmos-utils/gov"
+	"github.com/MalteHerrmann/evmos-utils/utils"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
@@ -91,3 +93,84 @@ func TestGetProposalID(t *testing.T) {
 		})
 	}
 }
+
+//nolint:funlen // function length is okay for tests
+func TestFindPendingUpgradeProposalInResponse(t *testing.T) {
+	t.Parallel()
+
+	cdc, ok := utils.GetCodec()
+	require.True(t, ok, "unexpected error getting codec")
+
+	buildProposal := func(id int, status, planName string) string {
+		//nolint:lll // line length is okay here
+		return fmt.Sprintf(`{"id":"%d","messages":[{"@type":"/cosmos.gov.v1.MsgExecLegacyContent","content":{"@type":"/cosmos.upgrade.v1beta1.SoftwareUpgradeProposal","title":"Upgrade","description":"Upgrade","plan":{"name":"%s","time":"0001-01-01T00:00:00Z","height":"151","info":"","upgraded_client_state":null}},"authority":"evmos10d07y265gmmuvt4z0w9aw880jnsr700jcrztvm"}],"status":"%s","final_tally_result":{"yes_count":"0","abstain_count":"0","no_count":"0","no_with_veto_count":"0"},"submit_time":"2023-08-23T21:16:24Z","deposit_end_time":"2023-08-23T21:21:24Z","total_deposit":[],"voting_start_time":null,"voting_end_time":null,"metadata":"","title":"","summary":"","proposer":""}`, id, planName, status)
+	}
+
+	testcases := []struct {
+		name        string
+		out         string
+		planName    string
+		expFound    bool
+		expID       uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			name: "pass - pending proposal found",
+			out: fmt.Sprintf(`{"proposals":[%s,%s],"pagination":{"next_key":null,"total":"2"}}`,
+				buildProposal(1, "PROPOSAL_STATUS_PASSED", "v16.0.0"),
+				buildProposal(2, "PROPOSAL_STATUS_VOTING_PERIOD", "v17.0.0"),
+			),
+			planName: "v17.0.0",
+			expFound: true,
+			expID:    2,
+		},
+		{
+			name: "pass - proposal not pending",
+			out: fmt.Sprintf(`{"proposals":[%s],"pagination":{"next_key":null,"total":"1"}}`,
+				buildProposal(1, "PROPOSAL_STATUS_REJECTED", "v17.0.0"),
+			),
+			planName: "v17.0.0",
+		},
+		{
+			name: "pass - different plan name",
+			out: fmt.Sprintf(`{"proposals":[%s],"pagination":{"next_key":null,"total":"1"}}`,
+				buildProposal(1, "PROPOSAL_STATUS_DEPOSIT_PERIOD", "v16.0.0"),
+			),
+			planName: "v17.0.0",
+		},
+		{
+			name:        "fail - invalid output",
+			out:         "invalid output",
+			planName:    "v17.0.0",
+			expError:    true,
+			errContains: "error unmarshalling proposals",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			proposal, plan, err := gov.FindPendingUpgradeProposalInResponse(cdc, tc.out, tc.planName)
+			if tc.expError {
+				require.Error(t, err, "expected error finding upgrade proposal")
+				require.ErrorContains(t, err, tc.errContains, "expected different error")
+
+				return
+			}
+
+			require.NoError(t, err, "unexpected error finding upgrade proposal")
+
+			if !tc.expFound {
+				require.Nil(t, proposal, "expected no proposal to be found")
+
+				return
+			}
+
+			require.NotNil(t, proposal, "expected proposal to be found")
+			require.Equal(t, tc.expID, proposal.Id, "expected different proposal ID")
+			require.Equal(t, tc.planName, plan.Name, "expected different plan name")
+		})
+	}
+}
@@ -21,6 +21,28 @@ func SubmitAllVotes(bin *utils.Binary, args []string) (int, error) {
 
 // SubmitAllVotesForProposal submits a vote for the given proposal ID using all testing accounts.
 func SubmitAllVotesForProposal(bin *utils.Binary, proposalID int) error {
+	return SubmitVotesForProposal(bin, proposalID, nil, nil)
+}
+
+// VoteRecorder is called after each successful vote with the name of the voting account
+// and the hash of the vote transaction.
+type VoteRecorder func(account, txHash string) error
+
+// SubmitVotesForProposal submits a vote for the given proposal ID using all selected accounts
+// with delegations, except for the accounts contained in the given skip set.
+// If a recorder is passed, it is called after every successful vote.
+func SubmitVotesForProposal(bin *utils.Binary, proposalID int, skip map[string]bool, record VoteRecorder) error {
+	return submitVotes(bin, proposalID, skip, record, func(acc utils.Account) (string, error) {
+		return VoteForProposal(bin, proposalID, acc.Name)
+	})
+}
+
+// submitVotes submits a vote for the given proposal ID with the given vote function using all selected accounts
+// with delegations, except for the accounts contained in the given skip set.
+func submitVotes(
+	bin *utils.Binary, proposalID int, skip map[string]bool, record VoteRecorder,
+	vote func(acc utils.Account) (string, error),
+) error {
 	accsWithDelegations, err := utils.FilterAccountsWithDelegations(bin)
 	if err != nil {
 		return errors.Wrap(err, "error filtering accounts")
@@ -30,19 +52,47 @@ func SubmitAllVotesForProposal(bin *utils.Binary, proposalID int) error {
 		return errors.New("no accounts with delegations found")
 	}
 
+	var accsToVote []utils.Account
+
+	for _, acc := range accsWithDelegations {
+		if skip[acc.Name] {
+			bin.Logger.Info().Msgf("skipping key %s, which already voted", acc.Name)
+
+			continue
+		}
+
+		accsToVote = append(accsToVote, acc)
+	}
+
+	if len(accsToVote) == 0 {
+		return nil
+	}
+
+	return castVotes(bin, proposalID, accsToVote, record, vote)
+}
+
+// castVotes submits a vote for the given proposal ID with the given vote function for each of the given accounts.
+// If a recorder is passed, it is called after every successful vote.
+func castVotes(
+	bin *utils.Binary, proposalID int, accsToVote []utils.Account, record VoteRecorder,
+	vote func(acc utils.Account) (string, error),
+) error {
 	if err := utils.WaitNBlocks(bin, 1); err != nil {
 		return errors.Wrapf(err, "error waiting for blocks")
 	}
 
 	bin.Logger.Info().Msgf("voting for proposal %d", proposalID)
 
-	var (
-		out             string
-		successfulVotes int
-	)
+	var successfulVotes int
+
+	for _, acc := range accsToVote {
+		out, err := vote(acc)
+
+		var txHash string
+		if err == nil && !bin.Config.DryRun {
+			txHash, err = utils.GetTxHashFromTxResponse(bin.Cdc, out)
+		}
 
-	for _, acc := range accsWithDelegations {
-		out, err = VoteForProposal(bin, proposalID, acc.Name)
 		if err != nil {
 			if strings.Contains(out, fmt.Sprintf("%d: unknown proposal", proposalID)) {
 				return fmt.Errorf("no proposal with ID %d found", proposalID)
@@ -53,11 +103,21 @@ func SubmitAllVotesForProposal(bin *utils.Binary, proposalID int) error {
 			}
 
 			bin.Logger.Error().Msgf("could not vote using key %s: %v", acc.Name, err)
-		} else {
-			bin.Logger.Info().Msgf("voted using key %s", acc.Name)
 
-			successfulVotes++
+			continue
 		}
+
+		if record != nil && !bin.Config.DryRun {
+			if err = record(acc.Name, txHash); err != nil {
+				bin.Logger.Error().Msgf("could not record vote using key %s: %v", acc.Name, err)
+
+				continue
+			}
+		}
+
+		bin.Logger.Info().Msgf("voted using key %s", acc.Name)
+
+		successfulVotes++
 	}
 
 	if successfulVotes == 0 {
@@ -70,7 +130,7 @@ func SubmitAllVotesForProposal(bin *utils.Binary, proposalID int) error {
 // VoteForProposal votes for the proposal with the given ID using the given account.
 func VoteForProposal(bin *utils.Binary, proposalID int, sender string) (string, error) {
 	out, err := utils.ExecuteTx(bin, utils.TxArgs{
-		Subcommand: []string{"tx", "gov", "vote", strconv.Itoa(proposalID), "yes"},
+		Subcommand: []string{"tx", "gov", "vote", strconv.Itoa(proposalID), "yes", "--output", "json"},
 		From:       sender,
 		Quiet:      true,
 	})
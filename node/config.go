@@ -0,0 +1,146 @@
+package node
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// AppConfigFile is the name of the application configuration file.
+	AppConfigFile = "app.toml"
+	// CometConfigFile is the name of the CometBFT configuration file.
+	CometConfigFile = "config.toml"
+)
+
+// GetConfigPath returns the path of the given configuration file in the given home directory.
+func GetConfigPath(home, file string) string {
+	return filepath.Join(home, "config", file)
+}
+
+// SetConfigValue sets the value of the given key in the TOML configuration file at the given path.
+// The key is given as "section.key" or just "key" for top-level entries, e.g. "rpc.laddr".
+// Comments and formatting of the file are preserved.
+func SetConfigValue(path, key, value string) error {
+	//#nosec G304 // path is built from the configured home directory
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return errors.Wrap(err, "error reading configuration file")
+	}
+
+	updated, err := SetTOMLValue(string(bz), key, value)
+	if err != nil {
+		return errors.Wrapf(err, "error updating %s", filepath.Base(path))
+	}
+
+	if err = os.WriteFile(path, []byte(updated), 0o600); err != nil {
+		return errors.Wrap(err, "error writing configuration file")
+	}
+
+	return nil
+}
+
+// tomlEntryPattern matches a key-value entry in a TOML file.
+var tomlEntryPattern = regexp.MustCompile(`^(\s*)([\w.-]+)(\s*=\s*)(.*)$`)
+
+// tomlSectionPattern matches a section header in a TOML file.
+var tomlSectionPattern = regexp.MustCompile(`^\s*\[([^\[\]]+)\]\s*(#.*)?$`)
+
+// GetTOMLValue returns the raw value of the given key in the given TOML contents.
+// String values are returned including their quotes.
+func GetTOMLValue(contents, key string) (string, error) {
+	lines := strings.Split(contents, "\n")
+
+	lineIdx, err := findTOMLEntry(lines, key)
+	if err != nil {
+		return "", err
+	}
+
+	match := tomlEntryPattern.FindStringSubmatch(lines[lineIdx])
+	rawValue, _ := splitTOMLComment(match[4])
+
+	return rawValue, nil
+}
+
+// SetTOMLValue sets the value of the given key in the given TOML contents and returns the updated contents.
+// If the existing value is a quoted string, the new value is quoted as well.
+// An inline comment following the existing value is kept.
+func SetTOMLValue(contents, key, value string) (string, error) {
+	lines := strings.Split(contents, "\n")
+
+	lineIdx, err := findTOMLEntry(lines, key)
+	if err != nil {
+		return "", err
+	}
+
+	match := tomlEntryPattern.FindStringSubmatch(lines[lineIdx])
+	if strings.HasPrefix(match[4], `"`) && !strings.HasPrefix(value, `"`) {
+		value = fmt.Sprintf("%q", value)
+	}
+
+	_, comment := splitTOMLComment(match[4])
+	lines[lineIdx] = match[1] + match[2] + match[3] + value + comment
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// splitTOMLComment splits the given raw value of a TOML entry into the value and the inline comment
+// including the whitespace preceding it. Comment characters inside quoted strings are ignored.
+func splitTOMLComment(rawValue string) (string, string) {
+	var quote rune
+
+	escaped := false
+
+	for i, char := range rawValue {
+		switch {
+		case escaped:
+			escaped = false
+		case quote == '"' && char == '\\':
+			escaped = true
+		case quote != 0:
+			if char == quote {
+				quote = 0
+			}
+		case char == '"' || char == '\'':
+			quote = char
+		case char == '#':
+			value := strings.TrimRight(rawValue[:i], " \t")
+
+			return value, rawValue[len(value):]
+		}
+	}
+
+	return rawValue, ""
+}
+
+// findTOMLEntry returns the index of the line containing the given key.
+func findTOMLEntry(lines []string, key string) (int, error) {
+	section, entryKey := "", key
+	if idx := strings.LastIndex(key, "."); idx >= 0 {
+		section, entryKey = key[:idx], key[idx+1:]
+	}
+
+	currentSection := ""
+
+	for i, line := range lines {
+		if match := tomlSectionPattern.FindStringSubmatch(line); match != nil {
+			currentSection = strings.TrimSpace(match[1])
+
+			continue
+		}
+
+		if currentSection != section {
+			continue
+		}
+
+		if match := tomlEntryPattern.FindStringSubmatch(line); match != nil && match[2] == entryKey {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("key %q not found", key)
+}
@@ -0,0 +1,99 @@
+package node_test
+
+import (
+	"testing"
+
+	"github.com/MalteHerrmann/evmos-utils/node"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfig = `# This is a TOML config file.
+proxy_app = "tcp://127.0.0.1:26658"
+
+#######################################################
+###       RPC Server Configuration Options          ###
+#######################################################
+[rpc]
+
+# TCP or UNIX socket address for the RPC server to listen on
+laddr = "tcp://127.0.0.1:26657"
+
+[p2p]
+
+# Address to listen for incoming connections
+laddr = "tcp://0.0.0.0:26656"
+allow_duplicate_ip = false
+max_num_inbound_peers = 40 # maximum number of inbound peers
+seeds = "a@b:26656#c" # comma separated list of seed nodes
+`
+
+func TestSetTOMLValue(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		key         string
+		value       string
+		expLine     string
+		expError    bool
+		errContains string
+	}{
+		{
+			name:    "pass - top level string",
+			key:     "proxy_app",
+			value:   "tcp://127.0.0.1:26758",
+			expLine: `proxy_app = "tcp://127.0.0.1:26758"`,
+		},
+		{
+			name:    "pass - same key in different section",
+			key:     "p2p.laddr",
+			value:   "tcp://0.0.0.0:26756",
+			expLine: `laddr = "tcp://0.0.0.0:26756"`,
+		},
+		{
+			name:    "pass - boolean",
+			key:     "p2p.allow_duplicate_ip",
+			value:   "true",
+			expLine: `allow_duplicate_ip = true`,
+		},
+		{
+			name:    "pass - inline comment is kept",
+			key:     "p2p.max_num_inbound_peers",
+			value:   "100",
+			expLine: `max_num_inbound_peers = 100 # maximum number of inbound peers`,
+		},
+		{
+			name:    "pass - comment character in quoted string",
+			key:     "p2p.seeds",
+			value:   "x@y:26656",
+			expLine: `seeds = "x@y:26656" # comma separated list of seed nodes`,
+		},
+		{
+			name:        "fail - unknown key",
+			key:         "rpc.unknown",
+			value:       "true",
+			expError:    true,
+			errContains: `key "rpc.unknown" not found`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			updated, err := node.SetTOMLValue(testConfig, tc.key, tc.value)
+			if tc.expError {
+				require.Error(t, err, "expected error setting value")
+				require.ErrorContains(t, err, tc.errContains, "expected different error")
+			} else {
+				require.NoError(t, err, "unexpected error setting value")
+				require.Contains(t, updated, tc.expLine, "expected updated line")
+				require.Contains(t, updated, "# TCP or UNIX socket address", "expected comments to be preserved")
+
+				value, err := node.GetTOMLValue(updated, tc.key)
+				require.NoError(t, err, "unexpected error getting value")
+				require.Contains(t, tc.expLine, value, "expected different value")
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package node
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/MalteHerrmann/evmos-utils/genesis"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/pkg/errors"
+)
+
+// InitOptions are the options to bootstrap a local node.
+type InitOptions struct {
+	// Moniker is the moniker of the local validator.
+	Moniker string
+	// NKeys is the number of test keys to create and fund.
+	NKeys int
+	// Balance is the amount of the fee denomination to fund every test key with.
+	Balance string
+	// SelfDelegation is the amount of the fee denomination the validator self-delegates.
+	SelfDelegation string
+	// MinDeposit is the minimum deposit amount for governance proposals.
+	MinDeposit string
+	// VotingPeriod is the voting period of governance proposals.
+	VotingPeriod time.Duration
+	// Overwrite defines whether an existing home directory is removed.
+	Overwrite bool
+	// Start defines whether the node is started in the background after the initialization.
+	Start bool
+	// Genesis is an optional genesis to use instead of the one created by the binary,
+	// e.g. the exported state of a live network.
+	Genesis genesis.Genesis
+}
+
+// GetKeyName returns the name of the test key with the given index.
+func GetKeyName(index int) string {
+	return fmt.Sprintf("dev%d", index)
+}
+
+// Init bootstraps a local node in the configured home directory. It initializes the genesis,
+// creates and funds test keys, applies the devnet settings to the genesis, creates the validator
+// using the first test key and optionally starts the node in the background.
+func Init(bin *utils.Binary, opts InitOptions) error {
+	if opts.NKeys < 1 {
+		return errors.New("at least one key is required to create the validator")
+	}
+
+	if err := prepareHomeDir(bin, opts.Overwrite); err != nil {
+		return err
+	}
+
+	bin.Logger.Info().Msgf("initializing node in %s", bin.Config.Home)
+
+	if err := runInitCommands(bin, opts); err != nil {
+		return err
+	}
+
+	if !opts.Start {
+		return nil
+	}
+
+	pid, err := Start(bin)
+	if err != nil {
+		return errors.Wrap(err, "error starting node")
+	}
+
+	bin.Logger.Info().Msgf("started node with PID %d; logs are written to %s", pid, GetLogFilePath(bin))
+
+	return WaitForRPC(bin, time.Minute)
+}
+
+// prepareHomeDir makes sure that the home directory does not exist yet
+// or removes it if it should be overwritten.
+func prepareHomeDir(bin *utils.Binary, overwrite bool) error {
+	if _, err := os.Stat(bin.Config.Home); os.IsNotExist(err) {
+		return nil
+	}
+
+	if !overwrite {
+		return fmt.Errorf("home directory %s already exists; use --overwrite to replace it", bin.Config.Home)
+	}
+
+	if pid, running := IsRunning(bin); running {
+		return fmt.Errorf("node is still running with PID %d; please stop it first", pid)
+	}
+
+	if err := os.RemoveAll(bin.Config.Home); err != nil {
+		return errors.Wrap(err, "error removing existing home directory")
+	}
+
+	return nil
+}
+
+// runInitCommands executes the binary commands to set up the genesis, keys and validator.
+func runInitCommands(bin *utils.Binary, opts InitOptions) error {
+	home := bin.Config.Home
+	keyring := bin.Config.KeyringBackend
+
+	commands := [][]string{
+		{"config", "keyring-backend", keyring, "--home", home},
+		{"config", "chain-id", bin.Config.ChainID, "--home", home},
+	}
+
+	for i := range opts.NKeys {
+		commands = append(commands, []string{
+			"keys", "add", GetKeyName(i), "--keyring-backend", keyring, "--algo", "eth_secp256k1", "--home", home,
+		})
+	}
+
+	commands = append(commands,
+		[]string{"init", opts.Moniker, "--chain-id", bin.Config.ChainID, "--home", home},
+	)
+
+	if err := ExecuteCommands(bin, commands); err != nil {
+		return err
+	}
+
+	if opts.Genesis != nil {
+		opts.Genesis["chain_id"] = bin.Config.ChainID
+		if err := opts.Genesis.Save(genesis.GetGenesisPath(home)); err != nil {
+			return errors.Wrap(err, "error replacing genesis")
+		}
+	}
+
+	if err := ApplyDevnetGenesis(bin, opts); err != nil {
+		return errors.Wrap(err, "error adjusting genesis")
+	}
+
+	commands = make([][]string, 0, opts.NKeys+3)
+	for i := range opts.NKeys {
+		commands = append(commands, []string{
+			"add-genesis-account", GetKeyName(i), opts.Balance + bin.Config.Denom,
+			"--keyring-backend", keyring, "--home", home,
+		})
+	}
+
+	commands = append(commands,
+		[]string{
+			"gentx", GetKeyName(0), opts.SelfDelegation + bin.Config.Denom,
+			"--chain-id", bin.Config.ChainID, "--keyring-backend", keyring, "--home", home,
+		},
+		[]string{"collect-gentxs", "--home", home},
+		[]string{"validate-genesis", "--home", home},
+	)
+
+	return ExecuteCommands(bin, commands)
+}
+
+// ExecuteCommands executes the given binary commands in order.
+func ExecuteCommands(bin *utils.Binary, commands [][]string) error {
+	for _, command := range commands {
+		bin.Logger.Debug().Msgf("executing: %s", utils.BuildShellCommand(command))
+
+		if _, err := utils.ExecuteBinaryCmd(bin, utils.BinaryCmdArgs{Subcommand: command}); err != nil {
+			return errors.Wrapf(err, "error executing %q", utils.BuildShellCommand(command))
+		}
+	}
+
+	return nil
+}
+
+// ApplyDevnetGenesis adjusts the genesis file in the configured home directory for local development.
+// It uses the configured denomination throughout all modules, shortens the governance periods
+// and lowers the minimum deposit.
+func ApplyDevnetGenesis(bin *utils.Binary, opts InitOptions) error {
+	genesisPath := genesis.GetGenesisPath(bin.Config.Home)
+
+	genesisState, err := genesis.Load(genesisPath)
+	if err != nil {
+		return err
+	}
+
+	denom := bin.Config.Denom
+	period := opts.VotingPeriod.String()
+
+	// NOTE: these fields are module specific, so they are only adjusted if present in the genesis
+	for path, value := range map[string]interface{}{
+		"app_state.staking.params.bond_denom":             denom,
+		"app_state.crisis.constant_fee.denom":             denom,
+		"app_state.evm.params.evm_denom":                  denom,
+		"app_state.inflation.params.mint_denom":           denom,
+		"app_state.mint.params.mint_denom":                denom,
+		"app_state.gov.deposit_params.max_deposit_period": period,
+		"app_state.gov.voting_params.voting_period":       period,
+	} {
+		genesisState.SetIfExists(path, value)
+	}
+
+	minDeposit := []interface{}{map[string]interface{}{"denom": denom, "amount": opts.MinDeposit}}
+
+	for path, value := range map[string]interface{}{
+		"app_state.gov.params.min_deposit":        minDeposit,
+		"app_state.gov.params.max_deposit_period": period,
+		"app_state.gov.params.voting_period":      period,
+	} {
+		if err = genesisState.Set(path, value); err != nil {
+			return err
+		}
+	}
+
+	genesisState.SetIfExists("app_state.gov.deposit_params.min_deposit", minDeposit)
+
+	return genesisState.Save(genesisPath)
+}
@@ -0,0 +1,55 @@
+package node_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/MalteHerrmann/evmos-utils/genesis"
+	"github.com/MalteHerrmann/evmos-utils/node"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyDevnetGenesis(t *testing.T) {
+	t.Parallel()
+
+	home := t.TempDir()
+	genesisPath := genesis.GetGenesisPath(home)
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(genesisPath), 0o750), "unexpected error creating config dir")
+	require.NoError(t, os.WriteFile(genesisPath, []byte(`{
+  "app_state": {
+    "staking": {"params": {"bond_denom": "stake"}},
+    "evm": {"params": {"evm_denom": "stake"}},
+    "gov": {
+      "params": {"min_deposit": [{"denom": "stake", "amount": "10000000"}], "voting_period": "172800s"}
+    }
+  }
+}`), 0o600), "unexpected error writing genesis")
+
+	bin := &utils.Binary{Config: utils.BinaryConfig{Denom: "aevmos", Home: home}}
+
+	err := node.ApplyDevnetGenesis(bin, node.InitOptions{MinDeposit: "1000", VotingPeriod: 30 * time.Second})
+	require.NoError(t, err, "unexpected error applying devnet genesis")
+
+	genesisState, err := genesis.Load(genesisPath)
+	require.NoError(t, err, "unexpected error loading genesis")
+
+	for path, expValue := range map[string]interface{}{
+		"app_state.staking.params.bond_denom":       "aevmos",
+		"app_state.evm.params.evm_denom":            "aevmos",
+		"app_state.gov.params.voting_period":        "30s",
+		"app_state.gov.params.max_deposit_period":   "30s",
+		"app_state.gov.params.min_deposit.0.denom":  "aevmos",
+		"app_state.gov.params.min_deposit.0.amount": "1000",
+	} {
+		value, err := genesisState.Get(path)
+		require.NoError(t, err, "unexpected error getting %s", path)
+		require.Equal(t, expValue, value, "expected different value for %s", path)
+	}
+
+	_, err = genesisState.Get("app_state.mint")
+	require.Error(t, err, "expected modules missing in the genesis not to be added")
+}
@@ -0,0 +1,87 @@
+package node
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// followInterval is the interval in which the log file is checked for new output when following it.
+const followInterval = 500 * time.Millisecond
+
+// PrintLogs writes the last given number of lines of the log file at the given path to the writer.
+// If the number of lines is not positive, the whole log file is written. If follow is set, new output
+// is streamed to the writer until the context is canceled.
+func PrintLogs(ctx context.Context, writer io.Writer, path string, nLines int, follow bool) error {
+	//#nosec G304 // path is built from the configured home directory
+	file, err := os.Open(path)
+	if err != nil {
+		return errors.Wrap(err, "error opening log file")
+	}
+
+	err = streamLogs(ctx, writer, file, nLines, follow)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
+
+// streamLogs writes the last given number of lines of the given log file to the writer
+// and optionally streams new output until the context is canceled.
+func streamLogs(ctx context.Context, writer io.Writer, file io.Reader, nLines int, follow bool) error {
+	contents, err := io.ReadAll(file)
+	if err != nil {
+		return errors.Wrap(err, "error reading log file")
+	}
+
+	if _, err = writer.Write(TailLines(contents, nLines)); err != nil {
+		return errors.Wrap(err, "error writing logs")
+	}
+
+	if !follow {
+		return nil
+	}
+
+	ticker := time.NewTicker(followInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if _, err = io.Copy(writer, file); err != nil {
+				return errors.Wrap(err, "error streaming logs")
+			}
+		}
+	}
+}
+
+// TailLines returns the last given number of lines of the given contents.
+// If the number of lines is not positive, the whole contents are returned.
+func TailLines(contents []byte, nLines int) []byte {
+	if nLines <= 0 {
+		return contents
+	}
+
+	// NOTE: a trailing newline does not start another line
+	end := len(contents)
+	if end > 0 && contents[end-1] == '\n' {
+		end--
+	}
+
+	start := end
+	for found := 0; found < nLines; found++ {
+		start = bytes.LastIndexByte(contents[:start], '\n')
+		if start < 0 {
+			return contents
+		}
+	}
+
+	return contents[start+1:]
+}
@@ -0,0 +1,58 @@
+package node_test
+
+import (
+	"testing"
+
+	"github.com/MalteHerrmann/evmos-utils/node"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTailLines(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		contents string
+		nLines   int
+		expected string
+	}{
+		{
+			name:     "pass - last lines",
+			contents: "a\nb\nc\nd\n",
+			nLines:   2,
+			expected: "c\nd\n",
+		},
+		{
+			name:     "pass - no trailing newline",
+			contents: "a\nb\nc",
+			nLines:   2,
+			expected: "b\nc",
+		},
+		{
+			name:     "pass - fewer lines than requested",
+			contents: "a\nb\n",
+			nLines:   5,
+			expected: "a\nb\n",
+		},
+		{
+			name:     "pass - all lines",
+			contents: "a\nb\n",
+			nLines:   0,
+			expected: "a\nb\n",
+		},
+		{
+			name:     "pass - empty",
+			contents: "",
+			nLines:   3,
+			expected: "",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.expected, string(node.TailLines([]byte(tc.contents), tc.nLines)))
+		})
+	}
+}
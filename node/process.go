@@ -0,0 +1,220 @@
+package node
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/pkg/errors"
+)
+
+const (
+	// pidFileName is the name of the file in the state directory, that contains the PID
+	// of the node process started by the tool.
+	pidFileName = "node.pid"
+	// logFileName is the name of the file in the state directory, that the node logs are written to.
+	logFileName = "node.log"
+	// stopTimeout is the maximum time to wait for the node process to exit after it was signaled.
+	stopTimeout = 30 * time.Second
+	// haltHeightTolerance is the number of blocks, that the node may have committed unnoticed
+	// between the last successful height query and exiting at its halt height.
+	haltHeightTolerance = 5
+)
+
+// GetPIDFilePath returns the path of the file containing the PID of the node process.
+func GetPIDFilePath(bin *utils.Binary) string {
+	return filepath.Join(utils.GetStateDir(bin), pidFileName)
+}
+
+// GetLogFilePath returns the path of the file that the node logs are written to.
+func GetLogFilePath(bin *utils.Binary) string {
+	return filepath.Join(utils.GetStateDir(bin), logFileName)
+}
+
+// Start launches the configured binary with the configured home directory as a background process.
+// The node output is appended to the log file in the state directory and the PID is stored
+// in the state directory, so that the process can be controlled by subsequent commands.
+func Start(bin *utils.Binary) (int, error) {
+	if pid, running := IsRunning(bin); running {
+		return 0, fmt.Errorf("node is already running with PID %d", pid)
+	}
+
+	if err := os.MkdirAll(utils.GetStateDir(bin), 0o750); err != nil {
+		return 0, errors.Wrap(err, "error creating state directory")
+	}
+
+	logFile, err := os.OpenFile(GetLogFilePath(bin), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return 0, errors.Wrap(err, "error opening log file")
+	}
+
+	//#nosec G204 // no risk of injection here because only internal commands are passed
+	cmd := exec.Command(bin.Config.Appd, "start", "--home", bin.Config.Home)
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	setProcessGroup(cmd)
+
+	err = cmd.Start()
+	// NOTE: the started process holds its own handle of the log file
+	if closeErr := logFile.Close(); closeErr != nil {
+		bin.Logger.Warn().Msgf("could not close log file: %v", closeErr)
+	}
+
+	if err != nil {
+		return 0, errors.Wrapf(err, "error starting %s", bin.Config.Appd)
+	}
+
+	pid := cmd.Process.Pid
+
+	// NOTE: the process is reaped in the background, so that it does not linger as a zombie process
+	// when it exits while the tool is still running.
+	go func() {
+		_ = cmd.Wait()
+	}()
+
+	if err = os.WriteFile(GetPIDFilePath(bin), []byte(strconv.Itoa(pid)), 0o600); err != nil {
+		return pid, errors.Wrap(err, "error writing PID file")
+	}
+
+	return pid, nil
+}
+
+// Stop terminates the node process that was started by the tool and waits for it to exit.
+func Stop(bin *utils.Binary) error {
+	pid, err := readPID(bin)
+	if err != nil {
+		return err
+	}
+
+	if processExists(pid) {
+		process, findErr := os.FindProcess(pid)
+		if findErr != nil {
+			return errors.Wrapf(findErr, "error finding process %d", pid)
+		}
+
+		if err = terminateProcess(process); err != nil {
+			return errors.Wrapf(err, "error stopping process %d", pid)
+		}
+
+		if !WaitForExit(bin, stopTimeout) {
+			bin.Logger.Warn().Msgf("node did not stop after %s; killing process %d", stopTimeout, pid)
+
+			if err = process.Kill(); err != nil {
+				return errors.Wrapf(err, "error killing process %d", pid)
+			}
+		}
+	}
+
+	if err = os.Remove(GetPIDFilePath(bin)); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "error removing PID file")
+	}
+
+	return nil
+}
+
+// IsRunning returns the PID of the node process started by the tool
+// and whether it is still running.
+func IsRunning(bin *utils.Binary) (int, bool) {
+	pid, err := readPID(bin)
+	if err != nil {
+		return 0, false
+	}
+
+	return pid, processExists(pid)
+}
+
+// WaitForExit waits until the node process started by the tool has exited.
+// It returns false if the process is still running after the given timeout.
+func WaitForExit(bin *utils.Binary, timeout time.Duration) bool {
+	start := time.Now()
+
+	for time.Since(start) < timeout {
+		if _, running := IsRunning(bin); !running {
+			return true
+		}
+
+		time.Sleep(500 * time.Millisecond)
+	}
+
+	return false
+}
+
+// WaitForHalt waits until the node has reached the given height, which is the last height
+// that is committed before the node halts, e.g. the block before the upgrade height.
+//
+// The node exits right after committing its last block, so the RPC endpoint can become unreachable
+// before the given height was observed. Failed queries are therefore not treated as errors.
+// Instead, the node is considered halted if its process has exited and the last observed height
+// was close enough to the given height. A timeout of zero means waiting indefinitely.
+func WaitForHalt(bin *utils.Binary, height int, timeout time.Duration) error {
+	var (
+		lastHeight int
+		start      = time.Now()
+	)
+
+	for {
+		currentHeight, err := utils.GetCurrentHeight(bin)
+		if err == nil && currentHeight >= height {
+			return nil
+		}
+
+		if err == nil {
+			lastHeight = currentHeight
+		} else if _, running := IsRunning(bin); !running {
+			if lastHeight+haltHeightTolerance < height {
+				return fmt.Errorf("node exited at height %d before reaching height %d", lastHeight, height)
+			}
+
+			bin.Logger.Debug().Msgf("node exited after height %d; considering it halted", lastHeight)
+
+			return nil
+		}
+
+		if timeout > 0 && time.Since(start) > timeout {
+			return fmt.Errorf("node did not halt at height %d after %s; last height: %d", height, timeout, lastHeight)
+		}
+
+		time.Sleep(time.Second)
+	}
+}
+
+// WaitForRPC waits until the RPC endpoint of the node is reachable and blocks are queryable.
+func WaitForRPC(bin *utils.Binary, timeout time.Duration) error {
+	start := time.Now()
+
+	for {
+		_, err := utils.ExecuteQuery(bin, utils.QueryArgs{
+			Subcommand: []string{"q", "block"},
+			Quiet:      true,
+		})
+		if err == nil {
+			return nil
+		}
+
+		if time.Since(start) > timeout {
+			return fmt.Errorf("node RPC at %s not reachable after %s", bin.Config.Node, timeout)
+		}
+
+		time.Sleep(time.Second)
+	}
+}
+
+// readPID reads the PID of the node process from the PID file in the state directory.
+func readPID(bin *utils.Binary) (int, error) {
+	bz, err := os.ReadFile(GetPIDFilePath(bin))
+	if err != nil {
+		return 0, errors.Wrap(err, "no node process started by evmos-utils found")
+	}
+
+	pid, err := strconv.Atoi(strings.TrimSpace(string(bz)))
+	if err != nil {
+		return 0, errors.Wrap(err, "invalid PID file")
+	}
+
+	return pid, nil
+}
@@ -0,0 +1,30 @@
+//go:build !unix
+
+package node
+
+import (
+	"os"
+	"os/exec"
+)
+
+// setProcessGroup is a no-op on platforms without process groups.
+func setProcessGroup(_ *exec.Cmd) {}
+
+// terminateProcess kills the given process, because graceful termination signals
+// are not supported on this platform.
+func terminateProcess(process *os.Process) error {
+	return process.Kill()
+}
+
+// processExists checks if a process with the given PID exists.
+// On Windows, finding a process fails if it does not exist.
+func processExists(pid int) bool {
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+
+	_ = process.Release()
+
+	return true
+}
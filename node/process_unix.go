@@ -0,0 +1,29 @@
+//go:build unix
+
+package node
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+// setProcessGroup runs the node in its own process group, so that it is not interrupted together with the tool.
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+// terminateProcess asks the given process to shut down gracefully.
+func terminateProcess(process *os.Process) error {
+	return process.Signal(syscall.SIGTERM)
+}
+
+// processExists checks if a process with the given PID exists.
+func processExists(pid int) bool {
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+
+	return process.Signal(syscall.Signal(0)) == nil
+}
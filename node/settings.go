@@ -0,0 +1,226 @@
+package node
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+)
+
+// Setting is a single entry in one of the configuration files of a node.
+type Setting struct {
+	// File is the name of the configuration file, i.e. AppConfigFile or CometConfigFile.
+	File string
+	// Key is the key of the entry given as "section.key" or just "key" for top-level entries.
+	Key string
+	// Value is the value of the entry.
+	Value string
+}
+
+// Presets are named groups of settings for common use cases.
+var Presets = map[string][]Setting{
+	"fast-blocks": {
+		{CometConfigFile, "consensus.timeout_propose", "1s"},
+		{CometConfigFile, "consensus.timeout_commit", "1s"},
+	},
+	"json-rpc": {
+		{AppConfigFile, "json-rpc.enable", "true"},
+		{AppConfigFile, "json-rpc.api", "eth,txpool,personal,net,debug,web3"},
+	},
+	"api": {
+		{AppConfigFile, "api.enable", "true"},
+		{AppConfigFile, "api.enabled-unsafe-cors", "true"},
+		{AppConfigFile, "grpc.enable", "true"},
+	},
+	"archive": {
+		{AppConfigFile, "pruning", "nothing"},
+	},
+}
+
+// GetPresetNames returns the sorted names of all available presets.
+func GetPresetNames() []string {
+	names := make([]string, 0, len(Presets))
+	for name := range Presets {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// jsonRPCNamespaces are the namespaces, that can be enabled for the JSON-RPC server.
+var jsonRPCNamespaces = []string{"eth", "net", "web3", "txpool", "debug", "personal", "miner"}
+
+// integerPattern matches integer values.
+var integerPattern = regexp.MustCompile(`^-?\d+$`)
+
+// knownKeyValidators contains the validation of the values for known configuration keys.
+var knownKeyValidators = map[string]func(value string) error{
+	"pruning":                       oneOf("default", "nothing", "everything", "custom"),
+	"halt-height":                   validateUint,
+	"halt-time":                     validateUint,
+	"pruning-keep-recent":           validateUint,
+	"pruning-interval":              validateUint,
+	"minimum-gas-prices":            validateGasPrices,
+	"api.enable":                    validateBool,
+	"grpc.enable":                   validateBool,
+	"json-rpc.enable":               validateBool,
+	"json-rpc.api":                  validateNamespaces,
+	"consensus.timeout_propose":     validateDuration,
+	"consensus.timeout_commit":      validateDuration,
+	"consensus.create_empty_blocks": validateBool,
+}
+
+// ResolveConfigFile returns the name of the configuration file in the given home directory,
+// that contains the given key. It fails if the key is contained in none or both of the files.
+func ResolveConfigFile(home, key string) (string, error) {
+	var found []string
+
+	for _, file := range []string{AppConfigFile, CometConfigFile} {
+		if _, err := GetConfigValue(GetConfigPath(home, file), key); err == nil {
+			found = append(found, file)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return "", fmt.Errorf("key %q not found in %s or %s", key, AppConfigFile, CometConfigFile)
+	case 1:
+		return found[0], nil
+	default:
+		return "", fmt.Errorf("key %q found in %s and %s; please select the file", key, found[0], found[1])
+	}
+}
+
+// GetConfigValue returns the value of the given key in the TOML configuration file at the given path.
+// Quotes of string values are removed.
+func GetConfigValue(path, key string) (string, error) {
+	//#nosec G304 // path is built from the configured home directory
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return "", errors.Wrap(err, "error reading configuration file")
+	}
+
+	value, err := GetTOMLValue(string(bz), key)
+	if err != nil {
+		return "", err
+	}
+
+	if unquoted, unquoteErr := strconv.Unquote(value); unquoteErr == nil {
+		return unquoted, nil
+	}
+
+	return value, nil
+}
+
+// ApplySetting validates the given setting and writes it to the configuration file in the given home directory.
+func ApplySetting(home string, setting Setting) error {
+	path := GetConfigPath(home, setting.File)
+
+	//#nosec G304 // path is built from the configured home directory
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return errors.Wrap(err, "error reading configuration file")
+	}
+
+	current, err := GetTOMLValue(string(bz), setting.Key)
+	if err != nil {
+		return errors.Wrapf(err, "error reading %s", setting.File)
+	}
+
+	if err = ValidateConfigValue(setting.Key, current, setting.Value); err != nil {
+		return errors.Wrapf(err, "invalid value for %s", setting.Key)
+	}
+
+	return SetConfigValue(path, setting.Key, setting.Value)
+}
+
+// ValidateConfigValue validates the given value for the given key. Values of known keys are validated
+// according to their meaning, while the values of other keys have to match the type of the current raw value.
+func ValidateConfigValue(key, current, value string) error {
+	if validate, known := knownKeyValidators[key]; known {
+		return validate(value)
+	}
+
+	switch {
+	case current == "true" || current == "false":
+		return validateBool(value)
+	case integerPattern.MatchString(current):
+		if _, err := strconv.Atoi(value); err != nil {
+			return fmt.Errorf("expected an integer; got %q", value)
+		}
+	}
+
+	return nil
+}
+
+// oneOf returns a validation, that checks that the value is one of the given options.
+func oneOf(options ...string) func(string) error {
+	return func(value string) error {
+		for _, option := range options {
+			if value == option {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("expected one of %s; got %q", strings.Join(options, ", "), value)
+	}
+}
+
+// validateBool checks that the value is a boolean.
+func validateBool(value string) error {
+	if value != "true" && value != "false" {
+		return fmt.Errorf("expected true or false; got %q", value)
+	}
+
+	return nil
+}
+
+// validateUint checks that the value is a non-negative integer.
+func validateUint(value string) error {
+	if _, err := strconv.ParseUint(value, 10, 64); err != nil {
+		return fmt.Errorf("expected a non-negative integer; got %q", value)
+	}
+
+	return nil
+}
+
+// validateDuration checks that the value is a duration, e.g. "1s".
+func validateDuration(value string) error {
+	if _, err := time.ParseDuration(value); err != nil {
+		return fmt.Errorf("expected a duration; got %q", value)
+	}
+
+	return nil
+}
+
+// validateGasPrices checks that the value is a list of gas prices, e.g. "0.0001aevmos".
+func validateGasPrices(value string) error {
+	if value == "" {
+		return nil
+	}
+
+	if _, err := sdk.ParseDecCoins(value); err != nil {
+		return fmt.Errorf("expected gas prices; got %q", value)
+	}
+
+	return nil
+}
+
+// validateNamespaces checks that the value is a comma-separated list of JSON-RPC namespaces.
+func validateNamespaces(value string) error {
+	for _, namespace := range strings.Split(value, ",") {
+		if err := oneOf(jsonRPCNamespaces...)(strings.TrimSpace(namespace)); err != nil {
+			return errors.Wrap(err, "invalid JSON-RPC namespace")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package node_test
+
+import (
+	"testing"
+
+	"github.com/MalteHerrmann/evmos-utils/node"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateConfigValue(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		key      string
+		current  string
+		value    string
+		expError bool
+	}{
+		{
+			name:    "pass - known duration",
+			key:     "consensus.timeout_commit",
+			current: `"5s"`,
+			value:   "1s",
+		},
+		{
+			name:     "fail - invalid duration",
+			key:      "consensus.timeout_commit",
+			current:  `"5s"`,
+			value:    "fast",
+			expError: true,
+		},
+		{
+			name:    "pass - known option",
+			key:     "pruning",
+			current: `"default"`,
+			value:   "nothing",
+		},
+		{
+			name:     "fail - unknown option",
+			key:      "pruning",
+			current:  `"default"`,
+			value:    "sometimes",
+			expError: true,
+		},
+		{
+			name:    "pass - JSON-RPC namespaces",
+			key:     "json-rpc.api",
+			current: `"eth,net,web3"`,
+			value:   "eth, txpool,debug",
+		},
+		{
+			name:     "fail - unknown JSON-RPC namespace",
+			key:      "json-rpc.api",
+			current:  `"eth,net,web3"`,
+			value:    "eth,admin",
+			expError: true,
+		},
+		{
+			name:     "fail - negative halt height",
+			key:      "halt-height",
+			current:  "0",
+			value:    "-1",
+			expError: true,
+		},
+		{
+			name:    "pass - gas prices",
+			key:     "minimum-gas-prices",
+			current: `"0aevmos"`,
+			value:   "0.0001aevmos",
+		},
+		{
+			name:    "pass - unknown key with bool value",
+			key:     "p2p.allow_duplicate_ip",
+			current: "false",
+			value:   "true",
+		},
+		{
+			name:     "fail - unknown key with invalid bool value",
+			key:      "p2p.allow_duplicate_ip",
+			current:  "false",
+			value:    "yes",
+			expError: true,
+		},
+		{
+			name:     "fail - unknown key with invalid integer value",
+			key:      "mempool.size",
+			current:  "5000",
+			value:    "many",
+			expError: true,
+		},
+		{
+			name:    "pass - unknown key with string value",
+			key:     "moniker",
+			current: `"localtestnet"`,
+			value:   "anything goes",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := node.ValidateConfigValue(tc.key, tc.current, tc.value)
+			if tc.expError {
+				require.Error(t, err, "expected error validating value")
+			} else {
+				require.NoError(t, err, "unexpected error validating value")
+			}
+		})
+	}
+}
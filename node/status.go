@@ -0,0 +1,95 @@
+package node
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/pkg/errors"
+)
+
+// Status is the status of the local node.
+type Status struct {
+	// Running defines whether the node process started by the tool is running.
+	Running bool `json:"running"`
+	// PID is the PID of the node process started by the tool.
+	PID int `json:"pid,omitempty"`
+	// RPCReachable defines whether the RPC endpoint of the node is reachable.
+	RPCReachable bool `json:"rpc_reachable"`
+	// Height is the latest block height of the node.
+	Height int `json:"height,omitempty"`
+	// LogFile is the path of the file that the node logs are written to.
+	LogFile string `json:"log_file"`
+}
+
+// String returns a human-readable representation of the status.
+func (s Status) String() string {
+	var builder strings.Builder
+
+	if s.Running {
+		builder.WriteString(fmt.Sprintf("process:  running (PID %d)\n", s.PID))
+	} else {
+		builder.WriteString("process:  not running\n")
+	}
+
+	if s.RPCReachable {
+		builder.WriteString(fmt.Sprintf("rpc:      reachable (height %d)\n", s.Height))
+	} else {
+		builder.WriteString("rpc:      not reachable\n")
+	}
+
+	builder.WriteString(fmt.Sprintf("logs:     %s", s.LogFile))
+
+	return builder.String()
+}
+
+// JSON returns the JSON representation of the status.
+func (s Status) JSON() (string, error) {
+	bz, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return "", errors.Wrap(err, "error marshalling status")
+	}
+
+	return string(bz), nil
+}
+
+// GetStatus returns the status of the node process started by the tool and its RPC endpoint.
+// The RPC endpoint is checked independently of the process, so that nodes started
+// outside of the tool are reported as well.
+func GetStatus(bin *utils.Binary) Status {
+	pid, running := IsRunning(bin)
+
+	status := Status{
+		Running: running,
+		LogFile: GetLogFilePath(bin),
+	}
+
+	if running {
+		status.PID = pid
+	}
+
+	if !isRPCReachable(bin) {
+		return status
+	}
+
+	height, err := utils.GetCurrentHeight(bin)
+	if err != nil {
+		return status
+	}
+
+	status.RPCReachable = true
+	status.Height = height
+
+	return status
+}
+
+// isRPCReachable checks if blocks can be queried from the RPC endpoint of the node.
+func isRPCReachable(bin *utils.Binary) bool {
+	_, err := utils.ExecuteQuery(bin, utils.QueryArgs{
+		Subcommand: []string{"q", "block"},
+		Quiet:      true,
+	})
+
+	return err == nil
+}
@@ -0,0 +1,182 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Change describes a difference of a single entry between two snapshots.
+// Added entries have an empty value before, removed entries have an empty value after.
+type Change struct {
+	Key      string `json:"key"`
+	Before   string `json:"before"`
+	After    string `json:"after"`
+	Expected bool   `json:"expected"`
+}
+
+// String returns a human-readable representation of the change.
+func (c Change) String() string {
+	marker := "!"
+	if c.Expected {
+		marker = "~"
+	}
+
+	switch {
+	case c.Before == "":
+		return fmt.Sprintf("%s %s: added %s", marker, c.Key, c.After)
+	case c.After == "":
+		return fmt.Sprintf("%s %s: removed %s", marker, c.Key, c.Before)
+	default:
+		return fmt.Sprintf("%s %s: %s -> %s", marker, c.Key, c.Before, c.After)
+	}
+}
+
+// Allowlist contains the patterns of entries, that are expected to change
+// between two snapshots, e.g. because of a migration in an upgrade.
+// A "*" in a pattern matches any sequence of characters.
+type Allowlist []*regexp.Regexp
+
+// Matches returns true if the given key matches any of the patterns in the allowlist.
+func (a Allowlist) Matches(key string) bool {
+	for _, pattern := range a {
+		if pattern.MatchString(key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseAllowlist parses the given patterns into an allowlist.
+// Empty lines and lines starting with "#" are ignored.
+func ParseAllowlist(lines []string) (Allowlist, error) {
+	allowlist := make(Allowlist, 0, len(lines))
+
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		expr := "^" + strings.ReplaceAll(regexp.QuoteMeta(line), `\*`, ".*") + "$"
+
+		pattern, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowlist pattern %q: %w", line, err)
+		}
+
+		allowlist = append(allowlist, pattern)
+	}
+
+	return allowlist, nil
+}
+
+// LoadAllowlist reads the allowlist from the file at the given path,
+// which contains one pattern per line.
+func LoadAllowlist(path string) (Allowlist, error) {
+	//#nosec G304 // path is explicitly passed by the user
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading allowlist: %w", err)
+	}
+
+	lines := strings.Split(string(bz), "\n")
+
+	return ParseAllowlist(lines)
+}
+
+// Diff compares the entries of the given snapshots and returns the sorted list of changes.
+// Changes to entries, that match the given allowlist, are marked as expected.
+func Diff(before, after Snapshot, allowlist Allowlist) []Change {
+	var changes []Change
+
+	for key, valueBefore := range before.Entries {
+		if valueAfter := after.Entries[key]; valueAfter != valueBefore {
+			changes = append(changes, Change{Key: key, Before: valueBefore, After: valueAfter})
+		}
+	}
+
+	for key, valueAfter := range after.Entries {
+		if _, found := before.Entries[key]; !found {
+			changes = append(changes, Change{Key: key, After: valueAfter})
+		}
+	}
+
+	for i := range changes {
+		changes[i].Expected = allowlist.Matches(changes[i].Key)
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Key < changes[j].Key
+	})
+
+	return changes
+}
+
+// FlattenJSON flattens the given JSON object into key-value pairs, where the keys
+// are the paths to the values joined by dots and prefixed with the given prefix.
+// Array elements are addressed by their denomination or ERC20 address if they have one
+// (e.g. token pairs), so that inserting an element does not change the keys of the other ones,
+// and by their index otherwise. Pagination information is omitted.
+func FlattenJSON(prefix string, bz []byte) (map[string]string, error) {
+	var value interface{}
+
+	if err := json.Unmarshal(bz, &value); err != nil {
+		return nil, fmt.Errorf("error unmarshalling json: %w", err)
+	}
+
+	entries := make(map[string]string)
+	flatten(prefix, value, entries)
+
+	return entries, nil
+}
+
+// flatten recursively adds the given value to the entries.
+func flatten(key string, value interface{}, entries map[string]string) {
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		for subKey, subValue := range typedValue {
+			if subKey == "pagination" {
+				continue
+			}
+
+			flatten(joinKey(key, subKey), subValue, entries)
+		}
+	case []interface{}:
+		for i, subValue := range typedValue {
+			flatten(joinKey(key, getElementKey(i, subValue)), subValue, entries)
+		}
+	case nil:
+		entries[key] = "null"
+	default:
+		entries[key] = fmt.Sprintf("%v", typedValue)
+	}
+}
+
+// getElementKey returns the key of the array element at the given index. Elements are identified
+// by their denomination or ERC20 address if available and by their index otherwise.
+func getElementKey(index int, value interface{}) string {
+	if object, ok := value.(map[string]interface{}); ok {
+		for _, idField := range []string{"denom", "erc20_address"} {
+			if id, ok := object[idField].(string); ok && id != "" {
+				return id
+			}
+		}
+	}
+
+	return strconv.Itoa(index)
+}
+
+// joinKey joins the given key with the sub key.
+func joinKey(key, subKey string) string {
+	if key == "" {
+		return subKey
+	}
+
+	return key + "." + subKey
+}
@@ -0,0 +1,101 @@
+package snapshot_test
+
+import (
+	"testing"
+
+	"github.com/MalteHerrmann/evmos-utils/snapshot"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenJSON(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		prefix      string
+		out         string
+		expEntries  map[string]string
+		expError    bool
+		errContains string
+	}{
+		{
+			name:   "pass",
+			prefix: "params.staking",
+			//nolint:lll // line length is okay here
+			out: `{"unbonding_time":"1814400s","max_validators":100,"jailed":false,"list":["a","b"],"pagination":{"total":"2"}}`,
+			expEntries: map[string]string{
+				"params.staking.unbonding_time": "1814400s",
+				"params.staking.max_validators": "100",
+				"params.staking.jailed":         "false",
+				"params.staking.list.0":         "a",
+				"params.staking.list.1":         "b",
+			},
+		},
+		{
+			name:   "pass - token pairs keyed by denom",
+			prefix: "erc20",
+			//nolint:lll // line length is okay here
+			out: `{"token_pairs":[{"erc20_address":"0xD494","denom":"aevmos","enabled":true},{"erc20_address":"0x80b5","denom":"ibc/ABC","enabled":false}]}`,
+			expEntries: map[string]string{
+				"erc20.token_pairs.aevmos.erc20_address":  "0xD494",
+				"erc20.token_pairs.aevmos.denom":          "aevmos",
+				"erc20.token_pairs.aevmos.enabled":        "true",
+				"erc20.token_pairs.ibc/ABC.erc20_address": "0x80b5",
+				"erc20.token_pairs.ibc/ABC.denom":         "ibc/ABC",
+				"erc20.token_pairs.ibc/ABC.enabled":       "false",
+			},
+		},
+		{
+			name:        "fail - invalid output",
+			out:         "invalid output",
+			expError:    true,
+			errContains: "error unmarshalling json",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			entries, err := snapshot.FlattenJSON(tc.prefix, []byte(tc.out))
+			if tc.expError {
+				require.Error(t, err, "expected error flattening json")
+				require.ErrorContains(t, err, tc.errContains, "expected different error")
+			} else {
+				require.NoError(t, err, "unexpected error flattening json")
+				require.Equal(t, tc.expEntries, entries, "expected different entries")
+			}
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	allowlist, err := snapshot.ParseAllowlist([]string{
+		"# expected migrations",
+		"params.staking.*",
+		"",
+	})
+	require.NoError(t, err, "unexpected error parsing allowlist")
+
+	before := snapshot.Snapshot{Entries: map[string]string{
+		"accounts.dev0.balances.aevmos": "100",
+		"accounts.dev1.balances.aevmos": "200",
+		"params.staking.max_validators": "100",
+	}}
+	after := snapshot.Snapshot{Entries: map[string]string{
+		"accounts.dev0.balances.aevmos": "100",
+		"accounts.dev1.balances.aevmos": "150",
+		"params.staking.max_validators": "120",
+		"supply.aevmos":                 "1000",
+	}}
+
+	expChanges := []snapshot.Change{
+		{Key: "accounts.dev1.balances.aevmos", Before: "200", After: "150"},
+		{Key: "params.staking.max_validators", Before: "100", After: "120", Expected: true},
+		{Key: "supply.aevmos", After: "1000"},
+	}
+
+	require.Equal(t, expChanges, snapshot.Diff(before, after, allowlist), "expected different changes")
+}
@@ -0,0 +1,258 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/pkg/errors"
+)
+
+// DefaultParamModules are the modules, whose parameters are recorded in a snapshot by default.
+var DefaultParamModules = []string{
+	"distribution", "erc20", "evm", "feemarket", "gov", "inflation", "slashing", "staking",
+}
+
+// Snapshot holds the recorded state of the chain at a given height.
+//
+// All recorded values are stored as flattened key-value pairs, e.g.
+// "accounts.dev0.balances.aevmos" or "params.staking.unbonding_time",
+// which makes it possible to compare any two snapshots entry by entry.
+type Snapshot struct {
+	Name    string            `json:"name"`
+	Height  int               `json:"height"`
+	Entries map[string]string `json:"entries"`
+}
+
+// Take records the balances and delegations of all keyring accounts, the total supply,
+// the registered ERC20 token pairs, the status and tally of all governance proposals
+// and the parameters of the given modules at the given height.
+// If the height is zero, the current height of the chain is used.
+func Take(bin *utils.Binary, name string, height int, paramModules []string) (Snapshot, error) {
+	var err error
+
+	if height == 0 {
+		height, err = utils.GetCurrentHeight(bin)
+		if err != nil {
+			return Snapshot{}, errors.Wrap(err, "error getting current height")
+		}
+	}
+
+	snapshot := Snapshot{
+		Name:    name,
+		Height:  height,
+		Entries: make(map[string]string),
+	}
+
+	for _, acc := range bin.Accounts {
+		if err = snapshot.addAccount(bin, acc); err != nil {
+			return Snapshot{}, errors.Wrapf(err, "error recording account %s", acc.Name)
+		}
+	}
+
+	if err = snapshot.addTotalSupply(bin); err != nil {
+		return Snapshot{}, errors.Wrap(err, "error recording total supply")
+	}
+
+	if err = snapshot.addQueryResult(bin, "erc20", []string{"q", "erc20", "token-pairs"}); err != nil {
+		bin.Logger.Warn().Msgf("could not record ERC20 token pairs: %v", err)
+	}
+
+	if err = snapshot.addProposals(bin); err != nil {
+		bin.Logger.Warn().Msgf("could not record governance proposals: %v", err)
+	}
+
+	for _, module := range paramModules {
+		err = snapshot.addQueryResult(bin, "params."+module, []string{"q", module, "params"})
+		if err != nil {
+			bin.Logger.Warn().Msgf("could not record parameters of module %s: %v", module, err)
+		}
+	}
+
+	return snapshot, nil
+}
+
+// addAccount records the balances and delegations of the given account.
+func (s *Snapshot) addAccount(bin *utils.Binary, acc utils.Account) error {
+	out, err := utils.ExecuteQuery(bin, utils.QueryArgs{
+		Subcommand: []string{"q", "bank", "balances", acc.Address, "--output=json"},
+		Height:     s.Height,
+		Quiet:      true,
+	})
+	if err != nil {
+		return errors.Wrap(err, "error querying balances")
+	}
+
+	balances, err := ParseBalancesFromResponse(bin.Cdc, out)
+	if err != nil {
+		return err
+	}
+
+	for _, coin := range balances {
+		s.Entries[fmt.Sprintf("accounts.%s.balances.%s", acc.Name, coin.Denom)] = coin.Amount.String()
+	}
+
+	out, err = utils.ExecuteQuery(bin, utils.QueryArgs{
+		Subcommand: []string{"q", "staking", "delegations", acc.Address, "--output=json"},
+		Height:     s.Height,
+		Quiet:      true,
+	})
+	if err != nil {
+		return errors.Wrap(err, "error querying delegations")
+	}
+
+	delegations, err := utils.ParseDelegationsFromResponse(bin.Cdc, out)
+	if err != nil {
+		return err
+	}
+
+	for _, delegation := range delegations {
+		key := fmt.Sprintf("accounts.%s.delegations.%s", acc.Name, delegation.ValidatorAddress)
+		s.Entries[key] = delegation.Shares.String()
+	}
+
+	return nil
+}
+
+// addTotalSupply records the total supply of all denominations.
+func (s *Snapshot) addTotalSupply(bin *utils.Binary) error {
+	out, err := utils.ExecuteQuery(bin, utils.QueryArgs{
+		Subcommand: []string{"q", "bank", "total", "--output=json"},
+		Height:     s.Height,
+		Quiet:      true,
+	})
+	if err != nil {
+		return errors.Wrap(err, "error querying total supply")
+	}
+
+	var res banktypes.QueryTotalSupplyResponse
+	if err = bin.Cdc.UnmarshalJSON([]byte(out), &res); err != nil {
+		return fmt.Errorf("error unmarshalling total supply: %w", err)
+	}
+
+	for _, coin := range res.Supply {
+		s.Entries["supply."+coin.Denom] = coin.Amount.String()
+	}
+
+	return nil
+}
+
+// addProposals records the status and final tally of all governance proposals.
+func (s *Snapshot) addProposals(bin *utils.Binary) error {
+	out, err := utils.ExecuteQuery(bin, utils.QueryArgs{
+		Subcommand: []string{"q", "gov", "proposals", "--output=json"},
+		Height:     s.Height,
+		Quiet:      true,
+	})
+	if err != nil {
+		// NOTE: the query fails if there are no proposals on chain yet
+		if strings.Contains(out, "no proposals found") {
+			return nil
+		}
+
+		return errors.Wrapf(err, "error querying proposals: %q", out)
+	}
+
+	entries, err := ParseProposals(out)
+	if err != nil {
+		return err
+	}
+
+	for key, value := range entries {
+		s.Entries[key] = value
+	}
+
+	return nil
+}
+
+// addQueryResult executes the given query and records its flattened JSON output
+// using the given prefix.
+func (s *Snapshot) addQueryResult(bin *utils.Binary, prefix string, query []string) error {
+	out, err := utils.ExecuteQuery(bin, utils.QueryArgs{
+		Subcommand: append(query, "--output=json"),
+		Height:     s.Height,
+		Quiet:      true,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "error executing query: %q", out)
+	}
+
+	entries, err := FlattenJSON(prefix, []byte(out))
+	if err != nil {
+		return err
+	}
+
+	for key, value := range entries {
+		s.Entries[key] = value
+	}
+
+	return nil
+}
+
+// ParseBalancesFromResponse parses the balances from the given output of the bank balances query.
+func ParseBalancesFromResponse(cdc *codec.ProtoCodec, out string) (sdk.Coins, error) {
+	var res banktypes.QueryAllBalancesResponse
+
+	err := cdc.UnmarshalJSON([]byte(out), &res)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling balances: %w", err)
+	}
+
+	return res.Balances, nil
+}
+
+// ParseProposals returns the status and final tally of the proposals in the given output
+// of the gov proposals query as flattened entries keyed by the proposal ID,
+// e.g. "gov.proposals.1.status" or "gov.proposals.1.final_tally_result.yes_count".
+func ParseProposals(out string) (map[string]string, error) {
+	var res struct {
+		Proposals []struct {
+			ID               string            `json:"id"`
+			Status           string            `json:"status"`
+			FinalTallyResult map[string]string `json:"final_tally_result"`
+		} `json:"proposals"`
+	}
+
+	if err := json.Unmarshal([]byte(out), &res); err != nil {
+		return nil, fmt.Errorf("error unmarshalling proposals: %w", err)
+	}
+
+	entries := make(map[string]string)
+
+	for _, proposal := range res.Proposals {
+		prefix := "gov.proposals." + proposal.ID
+		entries[prefix+".status"] = proposal.Status
+
+		for option, count := range proposal.FinalTallyResult {
+			entries[prefix+".final_tally_result."+option] = count
+		}
+	}
+
+	return entries, nil
+}
+
+// getSnapshotPath returns the path of the snapshot with the given name.
+func getSnapshotPath(bin *utils.Binary, name string) string {
+	return filepath.Join(utils.GetStateDir(bin), "snapshots", name+".json")
+}
+
+// Save stores the snapshot in the local state directory.
+func Save(bin *utils.Binary, snapshot Snapshot) error {
+	return utils.WriteJSONFile(getSnapshotPath(bin, snapshot.Name), snapshot)
+}
+
+// Load loads the snapshot with the given name from the local state directory.
+func Load(bin *utils.Binary, name string) (Snapshot, error) {
+	var snapshot Snapshot
+
+	if err := utils.ReadJSONFile(getSnapshotPath(bin, name), &snapshot); err != nil {
+		return Snapshot{}, errors.Wrapf(err, "error loading snapshot %s", name)
+	}
+
+	return snapshot, nil
+}
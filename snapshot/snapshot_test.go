@@ -0,0 +1,31 @@
+package snapshot_test
+
+import (
+	"testing"
+
+	"github.com/MalteHerrmann/evmos-utils/snapshot"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseProposals(t *testing.T) {
+	t.Parallel()
+
+	out := `{"proposals":[
+		{"id":"1","messages":[],"status":"PROPOSAL_STATUS_PASSED","final_tally_result":{
+			"yes_count":"100","abstain_count":"0","no_count":"0","no_with_veto_count":"0"
+		}},
+		{"id":"2","messages":[],"status":"PROPOSAL_STATUS_VOTING_PERIOD","final_tally_result":{
+			"yes_count":"0","abstain_count":"0","no_count":"0","no_with_veto_count":"0"
+		}}
+	],"pagination":{"next_key":null,"total":"2"}}`
+
+	entries, err := snapshot.ParseProposals(out)
+	require.NoError(t, err, "unexpected error parsing proposals")
+	require.Len(t, entries, 10, "expected status and four tally entries per proposal")
+	require.Equal(t, "PROPOSAL_STATUS_PASSED", entries["gov.proposals.1.status"], "expected different status")
+	require.Equal(t, "100", entries["gov.proposals.1.final_tally_result.yes_count"], "expected different tally")
+	require.Equal(t, "PROPOSAL_STATUS_VOTING_PERIOD", entries["gov.proposals.2.status"], "expected different status")
+
+	_, err = snapshot.ParseProposals("invalid")
+	require.ErrorContains(t, err, "error unmarshalling proposals", "expected error for invalid output")
+}
@@ -0,0 +1,279 @@
+package stake
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/pkg/errors"
+)
+
+// Delegate delegates the given amount to the given validator from all selected accounts.
+func Delegate(bin *utils.Binary, validator, amount string) error {
+	validatorAddress, err := ResolveValidator(bin, validator)
+	if err != nil {
+		return err
+	}
+
+	coin, err := ParseCoin(amount, bin.Config.Denom)
+	if err != nil {
+		return err
+	}
+
+	return forEachAccount(bin, "delegate", func(acc utils.Account) (string, error) {
+		return executeTx(bin, acc, "staking", "delegate", validatorAddress, coin.String())
+	})
+}
+
+// Redelegate redelegates the given amount from the source to the destination validator
+// for all selected accounts.
+func Redelegate(bin *utils.Binary, srcValidator, dstValidator, amount string) error {
+	srcAddress, err := ResolveValidator(bin, srcValidator)
+	if err != nil {
+		return err
+	}
+
+	dstAddress, err := ResolveValidator(bin, dstValidator)
+	if err != nil {
+		return err
+	}
+
+	coin, err := ParseCoin(amount, bin.Config.Denom)
+	if err != nil {
+		return err
+	}
+
+	return forEachAccount(bin, "redelegate", func(acc utils.Account) (string, error) {
+		return executeTx(bin, acc, "staking", "redelegate", srcAddress, dstAddress, coin.String())
+	})
+}
+
+// Unbond unbonds the given amount from the given validator for all selected accounts.
+func Unbond(bin *utils.Binary, validator, amount string) error {
+	validatorAddress, err := ResolveValidator(bin, validator)
+	if err != nil {
+		return err
+	}
+
+	coin, err := ParseCoin(amount, bin.Config.Denom)
+	if err != nil {
+		return err
+	}
+
+	return forEachAccount(bin, "unbond", func(acc utils.Account) (string, error) {
+		return executeTx(bin, acc, "staking", "unbond", validatorAddress, coin.String())
+	})
+}
+
+// WithdrawRewards withdraws the pending rewards of all delegations of the selected accounts.
+func WithdrawRewards(bin *utils.Binary) error {
+	return forEachAccount(bin, "withdraw rewards", func(acc utils.Account) (string, error) {
+		return executeTx(bin, acc, "distribution", "withdraw-all-rewards")
+	})
+}
+
+// Ensure delegates from every selected account, that has delegated less than the given minimum amount,
+// the missing amount to the given validator. If no validator is given, a random bonded validator is chosen
+// for every account. It returns the names of the accounts, that delegated.
+func Ensure(bin *utils.Binary, minAmount, validator string) ([]string, error) {
+	minCoin, err := ParseCoin(minAmount, bin.Config.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	var validators []string
+
+	if validator != "" {
+		validatorAddress, err := ResolveValidator(bin, validator)
+		if err != nil {
+			return nil, err
+		}
+
+		validators = []string{validatorAddress}
+	} else if validators, err = QueryBondedValidators(bin); err != nil {
+		return nil, err
+	}
+
+	var delegated []string
+
+	err = forEachAccount(bin, "ensure delegation", func(acc utils.Account) (string, error) {
+		delegations, err := QueryDelegations(bin, acc.Address)
+		if err != nil {
+			return "", err
+		}
+
+		missing := GetMissingStake(delegations, minCoin)
+		if missing.IsZero() {
+			bin.Logger.Info().Msgf("%s already delegates at least %s", acc.Name, minCoin)
+
+			return "", nil
+		}
+
+		//#nosec G404 // no cryptographic randomness needed to pick a validator
+		validatorAddress := validators[rand.Intn(len(validators))]
+
+		out, err := executeTx(bin, acc, "staking", "delegate", validatorAddress, missing.String())
+		if err == nil {
+			delegated = append(delegated, acc.Name)
+		}
+
+		return out, err
+	})
+
+	return delegated, err
+}
+
+// GetMissingStake returns the amount, that is missing from the given delegations to reach the minimum amount.
+func GetMissingStake(delegations []stakingtypes.DelegationResponse, minCoin sdk.Coin) sdk.Coin {
+	delegated := sdk.NewCoin(minCoin.Denom, sdk.ZeroInt())
+
+	for _, delegation := range delegations {
+		if delegation.Balance.Denom == minCoin.Denom {
+			delegated = delegated.Add(delegation.Balance)
+		}
+	}
+
+	if delegated.IsGTE(minCoin) {
+		return sdk.NewCoin(minCoin.Denom, sdk.ZeroInt())
+	}
+
+	return minCoin.Sub(delegated)
+}
+
+// ParseCoin parses the given amount into a single coin of the given base denomination, e.g. "1evmos".
+func ParseCoin(amount, baseDenom string) (sdk.Coin, error) {
+	coins, err := utils.ParseAmount(amount, baseDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if len(coins) != 1 || !coins[0].IsPositive() {
+		return sdk.Coin{}, fmt.Errorf("expected a single positive amount; got %q", amount)
+	}
+
+	return coins[0], nil
+}
+
+// ResolveValidator returns the validator operator address for the given validator operator address,
+// account address in any format or the name of a key in the keyring.
+func ResolveValidator(bin *utils.Binary, validator string) (string, error) {
+	address, err := bin.ResolveAddress(validator)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid validator")
+	}
+
+	addresses, err := utils.ConvertAddress(address, utils.Bech32Prefix)
+	if err != nil {
+		return "", err
+	}
+
+	return addresses.Validator, nil
+}
+
+// QueryBondedValidators returns the operator addresses of all bonded validators.
+func QueryBondedValidators(bin *utils.Binary) ([]string, error) {
+	out, err := utils.ExecuteQuery(bin, utils.QueryArgs{
+		Subcommand: []string{"q", "staking", "validators", "--output=json"},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error querying validators")
+	}
+
+	var res stakingtypes.QueryValidatorsResponse
+	if err = bin.Cdc.UnmarshalJSON([]byte(out), &res); err != nil {
+		return nil, fmt.Errorf("error unmarshalling validators: %w", err)
+	}
+
+	validators := make([]string, 0, len(res.Validators))
+
+	for _, validator := range res.Validators {
+		if validator.IsBonded() {
+			validators = append(validators, validator.OperatorAddress)
+		}
+	}
+
+	if len(validators) == 0 {
+		return nil, errors.New("no bonded validators found")
+	}
+
+	return validators, nil
+}
+
+// QueryDelegations returns the delegations of the given address.
+func QueryDelegations(bin *utils.Binary, address string) ([]stakingtypes.DelegationResponse, error) {
+	out, err := utils.ExecuteQuery(bin, utils.QueryArgs{
+		Subcommand: []string{"q", "staking", "delegations", address, "--output=json"},
+		Quiet:      true,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error querying delegations: %s", out)
+	}
+
+	var res stakingtypes.QueryDelegatorDelegationsResponse
+	if err = bin.Cdc.UnmarshalJSON([]byte(out), &res); err != nil {
+		return nil, fmt.Errorf("error unmarshalling delegations: %w", err)
+	}
+
+	return res.DelegationResponses, nil
+}
+
+// executeTx executes the given transaction of the given module from the given account.
+func executeTx(bin *utils.Binary, acc utils.Account, module string, args ...string) (string, error) {
+	subcommand := append([]string{"tx", module}, args...)
+
+	out, err := utils.ExecuteTx(bin, utils.TxArgs{
+		Subcommand: append(subcommand, "--output", "json"),
+		From:       acc.Name,
+		Quiet:      true,
+	})
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", err, strings.TrimSpace(out))
+	}
+
+	return out, nil
+}
+
+// forEachAccount executes the given transaction for all selected accounts. Failing transactions
+// are logged and an error is only returned, if none of the transactions succeeded.
+// An empty output without error means that no transaction was needed for the account
+// or that it was only planned in dry-run mode.
+func forEachAccount(bin *utils.Binary, action string, execute func(acc utils.Account) (string, error)) error {
+	accounts, err := bin.GetSelectedAccounts()
+	if err != nil {
+		return err
+	}
+
+	if len(accounts) == 0 {
+		return errors.New("no accounts selected")
+	}
+
+	var failed int
+
+	for _, acc := range accounts {
+		out, err := execute(acc)
+		if err == nil && out != "" {
+			_, err = utils.GetTxHashFromTxResponse(bin.Cdc, out)
+		}
+
+		if err != nil {
+			bin.Logger.Error().Msgf("could not %s using key %s: %v", action, acc.Name, err)
+
+			failed++
+
+			continue
+		}
+
+		if out != "" {
+			bin.Logger.Info().Msgf("%s using key %s", action, acc.Name)
+		}
+	}
+
+	if failed == len(accounts) {
+		return fmt.Errorf("could not %s using any of the selected keys, please check logs", action)
+	}
+
+	return nil
+}
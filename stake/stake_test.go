@@ -0,0 +1,82 @@
+package stake_test
+
+import (
+	"testing"
+
+	"github.com/MalteHerrmann/evmos-utils/stake"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMissingStake(t *testing.T) {
+	t.Parallel()
+
+	minCoin := sdk.NewInt64Coin("aevmos", 100)
+
+	testcases := []struct {
+		name        string
+		delegations []stakingtypes.DelegationResponse
+		expMissing  sdk.Coin
+	}{
+		{
+			name:       "no delegations",
+			expMissing: minCoin,
+		},
+		{
+			name: "partially delegated",
+			delegations: []stakingtypes.DelegationResponse{
+				{Balance: sdk.NewInt64Coin("aevmos", 30)},
+				{Balance: sdk.NewInt64Coin("aevmos", 20)},
+			},
+			expMissing: sdk.NewInt64Coin("aevmos", 50),
+		},
+		{
+			name:        "fully delegated",
+			delegations: []stakingtypes.DelegationResponse{{Balance: sdk.NewInt64Coin("aevmos", 150)}},
+			expMissing:  sdk.NewInt64Coin("aevmos", 0),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			missing := stake.GetMissingStake(tc.delegations, minCoin)
+			require.Equal(t, tc.expMissing.String(), missing.String(), "expected different missing stake")
+		})
+	}
+}
+
+func TestParseCoin(t *testing.T) {
+	t.Parallel()
+
+	coin, err := stake.ParseCoin("1evmos", "aevmos")
+	require.NoError(t, err, "expected no error parsing coin")
+	require.Equal(t, "1000000000000000000aevmos", coin.String(), "expected different coin")
+
+	_, err = stake.ParseCoin("1evmos,1uatom", "aevmos")
+	require.ErrorContains(t, err, "expected a single positive amount")
+
+	_, err = stake.ParseCoin("0", "aevmos")
+	require.ErrorContains(t, err, "expected a single positive amount")
+}
+
+func TestResolveValidator(t *testing.T) {
+	t.Parallel()
+
+	addresses, err := utils.ConvertAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", utils.Bech32Prefix)
+	require.NoError(t, err, "expected no error converting address")
+
+	bin := &utils.Binary{Accounts: []utils.Account{{Name: "validator", Address: addresses.Bech32}}}
+
+	for _, input := range []string{"validator", addresses.Validator, addresses.Bech32, addresses.Hex} {
+		validator, err := stake.ResolveValidator(bin, input)
+		require.NoError(t, err, "expected no error resolving %s", input)
+		require.Equal(t, addresses.Validator, validator, "expected validator address for %s", input)
+	}
+
+	_, err = stake.ResolveValidator(bin, "unknown")
+	require.ErrorContains(t, err, "invalid validator")
+}
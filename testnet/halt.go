@@ -0,0 +1,151 @@
+package testnet
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/MalteHerrmann/evmos-utils/node"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/pkg/errors"
+)
+
+const (
+	// haltHeightKey is the key of the halt height in the application configuration.
+	haltHeightKey = "halt-height"
+	// haltBlockTimeout is the maximum time to wait for a single block while approaching the halt height.
+	haltBlockTimeout = 30 * time.Second
+	// exitTimeout is the maximum time to wait for the nodes to exit after reaching the halt height.
+	exitTimeout = 30 * time.Second
+)
+
+// HaltOptions are the options for a coordinated halt of a local testnet.
+type HaltOptions struct {
+	// Height is the height after which all nodes halt.
+	Height int
+	// NewBinary is the binary to restart the nodes with after the halt.
+	// If empty, the nodes are restarted with the configured binary.
+	NewBinary string
+	// Blocks is the number of blocks that have to be produced after the restart.
+	Blocks int
+}
+
+// EmergencyHalt performs a coordinated halt of all nodes of the given testnet.
+// It sets the halt height in the application configuration of every node, restarts the nodes,
+// waits for all of them to stop at the halt height, swaps the binary, clears the halt height
+// and confirms that the chain continues producing blocks.
+func EmergencyHalt(bin *utils.Binary, testnet Testnet, opts HaltOptions) error {
+	if len(testnet.Nodes) == 0 {
+		return errors.New("testnet has no nodes")
+	}
+
+	newBinary := opts.NewBinary
+	if newBinary != "" {
+		var err error
+		if newBinary, err = utils.ResolveBinary(newBinary); err != nil {
+			return err
+		}
+	}
+
+	firstNode := GetNodeBinary(bin, testnet.Nodes[0])
+
+	currentHeight, err := utils.GetCurrentHeight(firstNode)
+	if err != nil {
+		return errors.Wrap(err, "error getting current height; make sure the testnet is running")
+	}
+
+	if opts.Height <= currentHeight {
+		return fmt.Errorf("halt height %d has to be above the current height %d", opts.Height, currentHeight)
+	}
+
+	bin.Logger.Info().Msgf("setting halt height %d on all nodes", opts.Height)
+
+	if err = setHaltHeight(testnet, opts.Height); err != nil {
+		return err
+	}
+
+	if err = Stop(bin, testnet); err != nil {
+		return err
+	}
+
+	if err = Start(bin, testnet); err != nil {
+		return err
+	}
+
+	if err = waitForHalt(bin, testnet, opts.Height); err != nil {
+		return err
+	}
+
+	if newBinary != "" {
+		bin.Logger.Info().Msgf("swapping binary to %s", newBinary)
+		bin.Config.Appd = newBinary
+	}
+
+	if err = setHaltHeight(testnet, 0); err != nil {
+		return err
+	}
+
+	if err = Start(bin, testnet); err != nil {
+		return err
+	}
+
+	// NOTE: the nodes are started with the new binary, so the first node has to be derived again
+	firstNode = GetNodeBinary(bin, testnet.Nodes[0])
+
+	timeout := time.Duration(opts.Blocks) * haltBlockTimeout
+	if err = utils.WaitNBlocksWithTimeout(firstNode, opts.Blocks, timeout); err != nil {
+		return errors.Wrap(err, "chain did not continue after the halt")
+	}
+
+	return nil
+}
+
+// setHaltHeight sets the halt height in the application configuration of all nodes.
+// A halt height of zero disables the halt.
+func setHaltHeight(testnet Testnet, height int) error {
+	for _, n := range testnet.Nodes {
+		setting := node.Setting{File: node.AppConfigFile, Key: haltHeightKey, Value: strconv.Itoa(height)}
+		if err := node.ApplySetting(n.Home, setting); err != nil {
+			return errors.Wrapf(err, "error setting halt height of %s", n.Name)
+		}
+	}
+
+	return nil
+}
+
+// waitForHalt waits until the testnet has reached the given halt height and all nodes have exited.
+func waitForHalt(bin *utils.Binary, testnet Testnet, height int) error {
+	firstNode := GetNodeBinary(bin, testnet.Nodes[0])
+
+	currentHeight, err := utils.GetCurrentHeight(firstNode)
+	if err != nil {
+		return err
+	}
+
+	bin.Logger.Info().Msgf("waiting for the nodes to halt at height %d", height)
+
+	// NOTE: the nodes exit right after committing the halt height, so the last queryable height
+	// might be the one before the halt height and the RPC endpoint can disappear in between two queries.
+	// This is handled by node.WaitForHalt and the halt is confirmed by the exit of every node.
+	timeout := time.Duration(height-currentHeight) * haltBlockTimeout
+	if err = node.WaitForHalt(firstNode, height-1, timeout); err != nil {
+		return errors.Wrap(err, "error waiting for halt height")
+	}
+
+	for _, n := range testnet.Nodes {
+		nodeBin := GetNodeBinary(bin, n)
+
+		if !node.WaitForExit(nodeBin, exitTimeout) {
+			return fmt.Errorf("%s did not halt at height %d", n.Name, height)
+		}
+
+		// NOTE: this removes the PID file of the exited process
+		if err = node.Stop(nodeBin); err != nil {
+			return errors.Wrapf(err, "error cleaning up %s", n.Name)
+		}
+
+		bin.Logger.Info().Msgf("%s halted", n.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,428 @@
+package testnet
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/MalteHerrmann/evmos-utils/genesis"
+	"github.com/MalteHerrmann/evmos-utils/node"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/pkg/errors"
+)
+
+// portOffset is the offset between the ports of two consecutive nodes.
+const portOffset = 100
+
+// Ports are the ports used by a single node of the testnet.
+type Ports struct {
+	P2P       int `json:"p2p"`
+	RPC       int `json:"rpc"`
+	PProf     int `json:"pprof"`
+	API       int `json:"api"`
+	GRPC      int `json:"grpc"`
+	GRPCWeb   int `json:"grpc_web"`
+	JSONRPC   int `json:"json_rpc"`
+	JSONRPCWS int `json:"json_rpc_ws"`
+}
+
+// GetPorts returns the ports for the node with the given index. The first node uses
+// the default ports, all other nodes use the default ports shifted by a multiple of 100.
+func GetPorts(index int) Ports {
+	offset := index * portOffset
+
+	return Ports{
+		P2P:       26656 + offset,
+		RPC:       26657 + offset,
+		PProf:     6060 + offset,
+		API:       1317 + offset,
+		GRPC:      9090 + offset,
+		GRPCWeb:   9091 + offset,
+		JSONRPC:   8545 + offset,
+		JSONRPCWS: 8546 + offset,
+	}
+}
+
+// Node holds the information about a single validator node of the testnet.
+type Node struct {
+	Name    string `json:"name"`
+	Home    string `json:"home"`
+	NodeID  string `json:"node_id"`
+	KeyName string `json:"key_name"`
+	Address string `json:"address"`
+	Stake   string `json:"stake"`
+	Ports   Ports  `json:"ports"`
+}
+
+// RPCAddress returns the address of the node's RPC endpoint.
+func (n Node) RPCAddress() string {
+	return fmt.Sprintf("http://localhost:%d", n.Ports.RPC)
+}
+
+// Testnet is the manifest of a local testnet, which is stored in its home directory.
+type Testnet struct {
+	ChainID string `json:"chain_id"`
+	Nodes   []Node `json:"nodes"`
+}
+
+// CreateOptions are the options to create a local testnet.
+type CreateOptions struct {
+	// Validators is the number of validator nodes.
+	Validators int
+	// Balance is the amount of the fee denomination to fund every validator key with.
+	Balance string
+	// SelfDelegation is the self-delegation of the last validator. The validator with index i
+	// self-delegates (Validators - i) times this amount, so that the stake is distributed unequally.
+	SelfDelegation string
+	// MinDeposit is the minimum deposit amount for governance proposals.
+	MinDeposit string
+	// VotingPeriod is the voting period of governance proposals.
+	VotingPeriod time.Duration
+	// Overwrite defines whether an existing testnet directory is removed.
+	Overwrite bool
+}
+
+// GetManifestPath returns the path of the testnet manifest in the given home directory.
+func GetManifestPath(home string) string {
+	return filepath.Join(home, utils.TestnetManifestFile)
+}
+
+// Load loads the testnet manifest from the configured home directory.
+func Load(bin *utils.Binary) (Testnet, error) {
+	var testnet Testnet
+
+	if err := utils.ReadJSONFile(GetManifestPath(bin.Config.Home), &testnet); err != nil {
+		return Testnet{}, errors.Wrap(err, "no local testnet found in home directory")
+	}
+
+	return testnet, nil
+}
+
+// GetNodeBinary returns a copy of the given binary, that is configured to use
+// the home directory and RPC endpoint of the given node.
+func GetNodeBinary(bin *utils.Binary, n Node) *utils.Binary {
+	nodeBin := *bin
+	nodeBin.Config.Home = n.Home
+	nodeBin.Config.Node = n.RPCAddress()
+
+	return &nodeBin
+}
+
+// Create lays out the node home directories of a local testnet in the configured home directory.
+// Every node has its own keyring containing its validator key, uses distinct ports and is connected
+// to all other nodes as persistent peers. All nodes share the same genesis, in which the validators
+// have unequal stake.
+func Create(bin *utils.Binary, opts CreateOptions) (Testnet, error) {
+	if opts.Validators < 1 {
+		return Testnet{}, errors.New("at least one validator is required")
+	}
+
+	if err := prepareHomeDir(bin, opts.Overwrite); err != nil {
+		return Testnet{}, err
+	}
+
+	testnet := Testnet{ChainID: bin.Config.ChainID}
+
+	for i := range opts.Validators {
+		stake, err := GetStake(opts.SelfDelegation, opts.Validators, i)
+		if err != nil {
+			return Testnet{}, err
+		}
+
+		n, err := initNode(bin, i, stake)
+		if err != nil {
+			return Testnet{}, errors.Wrapf(err, "error initializing node %d", i)
+		}
+
+		testnet.Nodes = append(testnet.Nodes, n)
+	}
+
+	if err := createGenesis(bin, testnet, opts); err != nil {
+		return Testnet{}, errors.Wrap(err, "error creating shared genesis")
+	}
+
+	for i, n := range testnet.Nodes {
+		if err := configureNode(n, testnet.Nodes); err != nil {
+			return Testnet{}, errors.Wrapf(err, "error configuring node %d", i)
+		}
+	}
+
+	if err := Save(bin, testnet); err != nil {
+		return Testnet{}, err
+	}
+
+	return testnet, nil
+}
+
+// Save writes the testnet manifest to the configured home directory.
+func Save(bin *utils.Binary, testnet Testnet) error {
+	return errors.Wrap(utils.WriteJSONFile(GetManifestPath(bin.Config.Home), testnet), "error writing testnet manifest")
+}
+
+// AddNode initializes the home directory and key of an additional node, which uses the shared genesis
+// of the testnet and is connected to all existing nodes as persistent peers. The node is not added
+// to the manifest, which has to be saved by the caller once the node is set up.
+func AddNode(bin *utils.Binary, testnet Testnet) (Node, error) {
+	if len(testnet.Nodes) == 0 {
+		return Node{}, errors.New("testnet has no nodes")
+	}
+
+	index := len(testnet.Nodes)
+
+	n, err := initNode(bin, index, "")
+	if err != nil {
+		return Node{}, errors.Wrapf(err, "error initializing node %d", index)
+	}
+
+	if err = copyGenesis(testnet.Nodes[0], n); err != nil {
+		return Node{}, err
+	}
+
+	if err = configureNode(n, append(testnet.Nodes, n)); err != nil {
+		return Node{}, errors.Wrapf(err, "error configuring %s", n.Name)
+	}
+
+	return n, nil
+}
+
+// prepareHomeDir makes sure that the testnet directory does not exist yet
+// or removes it if it should be overwritten.
+func prepareHomeDir(bin *utils.Binary, overwrite bool) error {
+	if _, err := os.Stat(bin.Config.Home); os.IsNotExist(err) {
+		return nil
+	}
+
+	if !overwrite {
+		return fmt.Errorf("directory %s already exists; use --overwrite to replace it", bin.Config.Home)
+	}
+
+	if testnet, err := Load(bin); err == nil {
+		if err = Stop(bin, testnet); err != nil {
+			return err
+		}
+	}
+
+	if err := os.RemoveAll(bin.Config.Home); err != nil {
+		return errors.Wrap(err, "error removing existing testnet directory")
+	}
+
+	return nil
+}
+
+// initNode initializes the home directory and validator key of the node with the given index.
+func initNode(bin *utils.Binary, index int, stake string) (Node, error) {
+	n := Node{
+		Name:    fmt.Sprintf("node%d", index),
+		Home:    filepath.Join(bin.Config.Home, fmt.Sprintf("node%d", index)),
+		KeyName: fmt.Sprintf("val%d", index),
+		Stake:   stake,
+		Ports:   GetPorts(index),
+	}
+
+	keyring := bin.Config.KeyringBackend
+	nodeBin := GetNodeBinary(bin, n)
+
+	err := node.ExecuteCommands(nodeBin, [][]string{
+		{"init", n.Name, "--chain-id", bin.Config.ChainID, "--home", n.Home},
+		{"config", "keyring-backend", keyring, "--home", n.Home},
+		{"config", "chain-id", bin.Config.ChainID, "--home", n.Home},
+		{"keys", "add", n.KeyName, "--keyring-backend", keyring, "--algo", "eth_secp256k1", "--home", n.Home},
+	})
+	if err != nil {
+		return Node{}, err
+	}
+
+	if n.Address, err = executeAndTrim(nodeBin,
+		"keys", "show", n.KeyName, "-a", "--keyring-backend", keyring, "--home", n.Home,
+	); err != nil {
+		return Node{}, err
+	}
+
+	if n.NodeID, err = executeAndTrim(nodeBin, "tendermint", "show-node-id", "--home", n.Home); err != nil {
+		return Node{}, err
+	}
+
+	return n, nil
+}
+
+// GetStake returns the self-delegation of the validator with the given index,
+// which is (validators - index) times the given base amount.
+func GetStake(baseAmount string, validators, index int) (string, error) {
+	amount, ok := new(big.Int).SetString(baseAmount, 10)
+	if !ok {
+		return "", fmt.Errorf("invalid self-delegation amount: %s", baseAmount)
+	}
+
+	return amount.Mul(amount, big.NewInt(int64(validators-index))).String(), nil
+}
+
+// createGenesis creates the shared genesis in the home of the first node, collects the
+// genesis transactions of all validators and distributes the final genesis to all nodes.
+func createGenesis(bin *utils.Binary, testnet Testnet, opts CreateOptions) error {
+	firstNode := testnet.Nodes[0]
+	firstBin := GetNodeBinary(bin, firstNode)
+
+	err := node.ApplyDevnetGenesis(firstBin, node.InitOptions{
+		MinDeposit:   opts.MinDeposit,
+		VotingPeriod: opts.VotingPeriod,
+	})
+	if err != nil {
+		return errors.Wrap(err, "error adjusting genesis")
+	}
+
+	commands := make([][]string, 0, len(testnet.Nodes))
+	for _, n := range testnet.Nodes {
+		commands = append(commands, []string{
+			"add-genesis-account", n.Address, opts.Balance + bin.Config.Denom, "--home", firstNode.Home,
+		})
+	}
+
+	if err = node.ExecuteCommands(firstBin, commands); err != nil {
+		return err
+	}
+
+	if err = distributeGenesis(testnet); err != nil {
+		return err
+	}
+
+	gentxDir := filepath.Join(firstNode.Home, "config", "gentx")
+	if err = os.MkdirAll(gentxDir, 0o750); err != nil {
+		return errors.Wrap(err, "error creating gentx directory")
+	}
+
+	for _, n := range testnet.Nodes {
+		err = node.ExecuteCommands(GetNodeBinary(bin, n), [][]string{{
+			"gentx", n.KeyName, n.Stake + bin.Config.Denom,
+			"--chain-id", bin.Config.ChainID, "--keyring-backend", bin.Config.KeyringBackend,
+			"--moniker", n.Name, "--home", n.Home,
+			"--output-document", filepath.Join(gentxDir, fmt.Sprintf("gentx-%s.json", n.Name)),
+		}})
+		if err != nil {
+			return err
+		}
+	}
+
+	err = node.ExecuteCommands(firstBin, [][]string{
+		{"collect-gentxs", "--home", firstNode.Home},
+		{"validate-genesis", "--home", firstNode.Home},
+	})
+	if err != nil {
+		return err
+	}
+
+	return distributeGenesis(testnet)
+}
+
+// distributeGenesis copies the genesis file of the first node to all other nodes.
+func distributeGenesis(testnet Testnet) error {
+	for _, n := range testnet.Nodes[1:] {
+		if err := copyGenesis(testnet.Nodes[0], n); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// copyGenesis copies the genesis file of the source node to the destination node.
+func copyGenesis(src, dst Node) error {
+	bz, err := os.ReadFile(genesis.GetGenesisPath(src.Home))
+	if err != nil {
+		return errors.Wrap(err, "error reading genesis")
+	}
+
+	if err = os.WriteFile(genesis.GetGenesisPath(dst.Home), bz, 0o600); err != nil {
+		return errors.Wrapf(err, "error writing genesis of %s", dst.Name)
+	}
+
+	return nil
+}
+
+// configureNode sets the ports and persistent peers of the given node.
+func configureNode(n Node, nodes []Node) error {
+	peers := make([]string, 0, len(nodes)-1)
+
+	for _, peer := range nodes {
+		if peer.Name != n.Name {
+			peers = append(peers, fmt.Sprintf("%s@127.0.0.1:%d", peer.NodeID, peer.Ports.P2P))
+		}
+	}
+
+	settings := []node.Setting{
+		{File: node.CometConfigFile, Key: "proxy_app", Value: fmt.Sprintf("tcp://127.0.0.1:%d", n.Ports.P2P+2)},
+		{File: node.CometConfigFile, Key: "rpc.laddr", Value: fmt.Sprintf("tcp://127.0.0.1:%d", n.Ports.RPC)},
+		{File: node.CometConfigFile, Key: "rpc.pprof_laddr", Value: fmt.Sprintf("localhost:%d", n.Ports.PProf)},
+		{File: node.CometConfigFile, Key: "p2p.laddr", Value: fmt.Sprintf("tcp://0.0.0.0:%d", n.Ports.P2P)},
+		{File: node.CometConfigFile, Key: "p2p.persistent_peers", Value: strings.Join(peers, ",")},
+		{File: node.CometConfigFile, Key: "p2p.allow_duplicate_ip", Value: "true"},
+		{File: node.CometConfigFile, Key: "p2p.addr_book_strict", Value: "false"},
+		{File: node.AppConfigFile, Key: "api.address", Value: fmt.Sprintf("tcp://0.0.0.0:%d", n.Ports.API)},
+		{File: node.AppConfigFile, Key: "grpc.address", Value: fmt.Sprintf("0.0.0.0:%d", n.Ports.GRPC)},
+		{File: node.AppConfigFile, Key: "grpc-web.address", Value: fmt.Sprintf("0.0.0.0:%d", n.Ports.GRPCWeb)},
+		{File: node.AppConfigFile, Key: "json-rpc.address", Value: fmt.Sprintf("0.0.0.0:%d", n.Ports.JSONRPC)},
+		{File: node.AppConfigFile, Key: "json-rpc.ws-address", Value: fmt.Sprintf("0.0.0.0:%d", n.Ports.JSONRPCWS)},
+	}
+
+	for _, setting := range settings {
+		if err := node.ApplySetting(n.Home, setting); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Start starts all nodes of the testnet as background processes and waits
+// until their RPC endpoints are reachable.
+func Start(bin *utils.Binary, testnet Testnet) error {
+	for _, n := range testnet.Nodes {
+		nodeBin := GetNodeBinary(bin, n)
+
+		pid, err := node.Start(nodeBin)
+		if err != nil {
+			return errors.Wrapf(err, "error starting %s", n.Name)
+		}
+
+		bin.Logger.Info().Msgf("started %s with PID %d (RPC: %s)", n.Name, pid, n.RPCAddress())
+	}
+
+	for _, n := range testnet.Nodes {
+		if err := node.WaitForRPC(GetNodeBinary(bin, n), time.Minute); err != nil {
+			return errors.Wrapf(err, "error waiting for %s", n.Name)
+		}
+	}
+
+	return nil
+}
+
+// Stop stops all running nodes of the testnet.
+func Stop(bin *utils.Binary, testnet Testnet) error {
+	for _, n := range testnet.Nodes {
+		nodeBin := GetNodeBinary(bin, n)
+
+		if _, running := node.IsRunning(nodeBin); !running {
+			continue
+		}
+
+		if err := node.Stop(nodeBin); err != nil {
+			return errors.Wrapf(err, "error stopping %s", n.Name)
+		}
+
+		bin.Logger.Info().Msgf("stopped %s", n.Name)
+	}
+
+	return nil
+}
+
+// executeAndTrim executes the given binary command and returns its trimmed output.
+func executeAndTrim(bin *utils.Binary, command ...string) (string, error) {
+	out, err := utils.ExecuteBinaryCmd(bin, utils.BinaryCmdArgs{Subcommand: command})
+	if err != nil {
+		return "", errors.Wrapf(err, "error executing %q", utils.BuildShellCommand(command))
+	}
+
+	return strings.TrimSpace(out), nil
+}
@@ -0,0 +1,67 @@
+package testnet_test
+
+import (
+	"testing"
+
+	"github.com/MalteHerrmann/evmos-utils/testnet"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetStake(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		baseAmount  string
+		validators  int
+		index       int
+		expStake    string
+		expError    bool
+		errContains string
+	}{
+		{
+			name:       "pass - first validator has the highest stake",
+			baseAmount: "1000000000000000000000",
+			validators: 3,
+			index:      0,
+			expStake:   "3000000000000000000000",
+		},
+		{
+			name:       "pass - last validator has the base stake",
+			baseAmount: "1000000000000000000000",
+			validators: 3,
+			index:      2,
+			expStake:   "1000000000000000000000",
+		},
+		{
+			name:        "fail - invalid amount",
+			baseAmount:  "invalid",
+			validators:  3,
+			expError:    true,
+			errContains: "invalid self-delegation amount",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			stake, err := testnet.GetStake(tc.baseAmount, tc.validators, tc.index)
+			if tc.expError {
+				require.Error(t, err, "expected error getting stake")
+				require.ErrorContains(t, err, tc.errContains, "expected different error")
+			} else {
+				require.NoError(t, err, "unexpected error getting stake")
+				require.Equal(t, tc.expStake, stake, "expected different stake")
+			}
+		})
+	}
+}
+
+func TestGetPorts(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, 26657, testnet.GetPorts(0).RPC, "expected default RPC port for first node")
+	require.Equal(t, 26857, testnet.GetPorts(2).RPC, "expected shifted RPC port for third node")
+	require.Equal(t, 8745, testnet.GetPorts(2).JSONRPC, "expected shifted JSON-RPC port for third node")
+}
@@ -0,0 +1,28 @@
+// Package testutil contains helpers shared by the tests of several packages.
+package testutil
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// WriteFakeBinary writes an executable script named "evmosd" to the given directory, that reports
+// the given version for "version" and detailed version information for "version --long".
+// All other commands fail.
+func WriteFakeBinary(t *testing.T, dir, version string) string {
+	t.Helper()
+
+	path := filepath.Join(dir, "evmosd")
+	script := "#!/bin/sh\n" +
+		"if [ \"$1\" != version ]; then exit 1; fi\n" +
+		"if [ \"$2\" = \"--long\" ]; then printf 'name: evmos\\nversion: %s\\n' " + version + "; exit 0; fi\n" +
+		"echo " + version + "\n"
+
+	//#nosec G306 // the fake binary has to be executable
+	require.NoError(t, os.WriteFile(path, []byte(script), 0o700), "unexpected error writing fake binary")
+
+	return path
+}
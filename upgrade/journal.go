@@ -0,0 +1,77 @@
+package upgrade
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/pkg/errors"
+)
+
+// Journal records the completed steps of preparing an upgrade, so that a failed
+// upgrade preparation can be resumed without submitting a second proposal.
+type Journal struct {
+	// TargetVersion is the name of the upgrade plan.
+	TargetVersion string `json:"target_version"`
+	// UpgradeHeight is the height at which the upgrade is scheduled.
+	UpgradeHeight int `json:"upgrade_height"`
+	// ProposalID is the ID of the submitted upgrade proposal.
+	ProposalID int `json:"proposal_id"`
+	// Deposited is true once the deposit for the proposal was made or was not necessary.
+	Deposited bool `json:"deposited"`
+	// DepositTxHash is the hash of the deposit transaction.
+	DepositTxHash string `json:"deposit_tx_hash"`
+	// Votes maps the names of the accounts, that voted on the proposal, to the vote transaction hashes.
+	Votes map[string]string `json:"votes"`
+}
+
+// NewJournal returns a new, empty journal for the given target version.
+func NewJournal(targetVersion string) Journal {
+	return Journal{
+		TargetVersion: targetVersion,
+		Votes:         make(map[string]string),
+	}
+}
+
+// getJournalPath returns the path of the journal for the given target version.
+func getJournalPath(bin *utils.Binary, targetVersion string) string {
+	return filepath.Join(utils.GetStateDir(bin), "journals", targetVersion+".json")
+}
+
+// LoadJournal loads the journal for the given target version from the local state directory.
+func LoadJournal(bin *utils.Binary, targetVersion string) (Journal, error) {
+	path := getJournalPath(bin, targetVersion)
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return Journal{}, errors.Errorf("no journal found to resume upgrade to %s", targetVersion)
+	}
+
+	journal := NewJournal(targetVersion)
+	if err := utils.ReadJSONFile(path, &journal); err != nil {
+		return Journal{}, errors.Wrap(err, "error loading journal")
+	}
+
+	if journal.Votes == nil {
+		journal.Votes = make(map[string]string)
+	}
+
+	return journal, nil
+}
+
+// Save stores the journal in the local state directory. In dry-run mode, nothing is stored.
+func (j *Journal) Save(bin *utils.Binary) error {
+	if bin.Config.DryRun {
+		return nil
+	}
+
+	return utils.WriteJSONFile(getJournalPath(bin, j.TargetVersion), j)
+}
+
+// VotedAccounts returns the set of accounts, that already voted on the proposal.
+func (j *Journal) VotedAccounts() map[string]bool {
+	voted := make(map[string]bool, len(j.Votes))
+	for account := range j.Votes {
+		voted[account] = true
+	}
+
+	return voted
+}
@@ -0,0 +1,109 @@
+package upgrade
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/pkg/errors"
+)
+
+// ModuleVersions maps the module names to their consensus versions.
+type ModuleVersions map[string]uint64
+
+// ModuleVersionChange describes the change of a module's consensus version
+// between two snapshots.
+type ModuleVersionChange struct {
+	Module string `json:"module"`
+	Before uint64 `json:"before"`
+	After  uint64 `json:"after"`
+}
+
+// QueryModuleVersions queries the current consensus versions of all modules.
+func QueryModuleVersions(bin *utils.Binary) (ModuleVersions, error) {
+	out, err := utils.ExecuteQuery(bin, utils.QueryArgs{
+		Subcommand: []string{"q", "upgrade", "module_versions", "--output=json"},
+		Quiet:      true,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error querying module versions")
+	}
+
+	return ParseModuleVersionsFromResponse(bin.Cdc, out)
+}
+
+// ParseModuleVersionsFromResponse parses the module versions from the given output
+// of the module versions query.
+func ParseModuleVersionsFromResponse(cdc *codec.ProtoCodec, out string) (ModuleVersions, error) {
+	var res upgradetypes.QueryModuleVersionsResponse
+
+	err := cdc.UnmarshalJSON([]byte(out), &res)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling module versions: %w", err)
+	}
+
+	versions := make(ModuleVersions, len(res.ModuleVersions))
+	for _, moduleVersion := range res.ModuleVersions {
+		versions[moduleVersion.Name] = moduleVersion.Version
+	}
+
+	return versions, nil
+}
+
+// getModuleVersionsPath returns the path of the module versions snapshot
+// that is taken before scheduling the upgrade with the given name.
+func getModuleVersionsPath(bin *utils.Binary, upgradeName string) string {
+	return filepath.Join(utils.GetStateDir(bin), "module_versions", upgradeName+".json")
+}
+
+// SnapshotModuleVersions queries the current module versions and stores them
+// in the local state directory, so that they can be compared after the upgrade
+// with the given name was applied.
+func SnapshotModuleVersions(bin *utils.Binary, upgradeName string) error {
+	versions, err := QueryModuleVersions(bin)
+	if err != nil {
+		return err
+	}
+
+	return utils.WriteJSONFile(getModuleVersionsPath(bin, upgradeName), versions)
+}
+
+// LoadModuleVersionsSnapshot loads the module versions, that were stored before
+// scheduling the upgrade with the given name.
+func LoadModuleVersionsSnapshot(bin *utils.Binary, upgradeName string) (ModuleVersions, error) {
+	var versions ModuleVersions
+
+	if err := utils.ReadJSONFile(getModuleVersionsPath(bin, upgradeName), &versions); err != nil {
+		return nil, errors.Wrapf(err, "no module versions snapshot found for upgrade %s", upgradeName)
+	}
+
+	return versions, nil
+}
+
+// DiffModuleVersions compares the given module versions and returns the changed, added
+// and removed modules. Added modules have a version of zero before the upgrade,
+// removed modules have a version of zero after the upgrade.
+func DiffModuleVersions(before, after ModuleVersions) []ModuleVersionChange {
+	var changes []ModuleVersionChange
+
+	for module, versionBefore := range before {
+		if versionAfter := after[module]; versionAfter != versionBefore {
+			changes = append(changes, ModuleVersionChange{Module: module, Before: versionBefore, After: versionAfter})
+		}
+	}
+
+	for module, versionAfter := range after {
+		if _, found := before[module]; !found {
+			changes = append(changes, ModuleVersionChange{Module: module, Before: 0, After: versionAfter})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Module < changes[j].Module
+	})
+
+	return changes
+}
@@ -0,0 +1,87 @@
+package upgrade_test
+
+import (
+	"testing"
+
+	"github.com/MalteHerrmann/evmos-utils/upgrade"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseModuleVersionsFromResponse(t *testing.T) {
+	t.Parallel()
+
+	cdc, ok := utils.GetCodec()
+	require.True(t, ok, "unexpected error getting codec")
+
+	testcases := []struct {
+		name        string
+		out         string
+		expVersions upgrade.ModuleVersions
+		expError    bool
+		errContains string
+	}{
+		{
+			name:        "pass",
+			out:         `{"module_versions":[{"name":"auth","version":"4"},{"name":"bank","version":"3"}]}`,
+			expVersions: upgrade.ModuleVersions{"auth": 4, "bank": 3},
+		},
+		{
+			name:        "fail - invalid output",
+			out:         "invalid output",
+			expError:    true,
+			errContains: "error unmarshalling module versions",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			versions, err := upgrade.ParseModuleVersionsFromResponse(cdc, tc.out)
+			if tc.expError {
+				require.Error(t, err, "expected error parsing module versions")
+				require.ErrorContains(t, err, tc.errContains, "expected different error")
+			} else {
+				require.NoError(t, err, "unexpected error parsing module versions")
+				require.Equal(t, tc.expVersions, versions, "expected different module versions")
+			}
+		})
+	}
+}
+
+func TestDiffModuleVersions(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name       string
+		before     upgrade.ModuleVersions
+		after      upgrade.ModuleVersions
+		expChanges []upgrade.ModuleVersionChange
+	}{
+		{
+			name:   "pass - no changes",
+			before: upgrade.ModuleVersions{"auth": 4, "bank": 3},
+			after:  upgrade.ModuleVersions{"auth": 4, "bank": 3},
+		},
+		{
+			name:   "pass - changed, added and removed modules",
+			before: upgrade.ModuleVersions{"auth": 4, "bank": 3, "claims": 2},
+			after:  upgrade.ModuleVersions{"auth": 4, "bank": 4, "erc20": 3},
+			expChanges: []upgrade.ModuleVersionChange{
+				{Module: "bank", Before: 3, After: 4},
+				{Module: "claims", Before: 2, After: 0},
+				{Module: "erc20", Before: 0, After: 3},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			changes := upgrade.DiffModuleVersions(tc.before, tc.after)
+			require.Equal(t, tc.expChanges, changes, "expected different module version changes")
+		})
+	}
+}
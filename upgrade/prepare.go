@@ -0,0 +1,146 @@
+package upgrade
+
+import (
+	"fmt"
+
+	"github.com/MalteHerrmann/evmos-utils/gov"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	govv1types "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/pkg/errors"
+)
+
+// Prepare prepares upgrading the local node to the target version
+// by submitting the upgrade proposal and voting on it using all testing accounts.
+// It returns the height at which the upgrade is scheduled.
+//
+// Every completed step is recorded in a journal in the local state directory.
+// If resume is true, the preparation picks up after the last completed step of the journal.
+// If there is already a pending upgrade proposal for the target version, it is reused
+// instead of submitting a new one.
+func Prepare(bin *utils.Binary, targetVersion string, resume bool) (int, error) {
+	var err error
+
+	journal := NewJournal(targetVersion)
+	if resume {
+		if journal, err = LoadJournal(bin, targetVersion); err != nil {
+			return 0, err
+		}
+
+		bin.Logger.Info().Msgf("resuming upgrade to %s with proposal %d", targetVersion, journal.ProposalID)
+	}
+
+	if journal.ProposalID == 0 {
+		if err = submitOrReuseProposal(bin, &journal); err != nil {
+			return 0, err
+		}
+	} else if err = checkUpgradeHeight(bin, journal.UpgradeHeight); err != nil {
+		return 0, err
+	}
+
+	if !journal.Deposited {
+		if err = depositForProposal(bin, &journal); err != nil {
+			return 0, err
+		}
+	}
+
+	err = gov.SubmitVotesForProposal(bin, journal.ProposalID, journal.VotedAccounts(),
+		func(account, txHash string) error {
+			journal.Votes[account] = txHash
+
+			return journal.Save(bin)
+		},
+	)
+	if err != nil {
+		return 0, errors.Wrapf(err, "error submitting votes for proposal %d", journal.ProposalID)
+	}
+
+	return journal.UpgradeHeight, nil
+}
+
+// submitOrReuseProposal submits the upgrade proposal for the journal's target version,
+// or reuses an existing pending upgrade proposal for the same version.
+func submitOrReuseProposal(bin *utils.Binary, journal *Journal) error {
+	currentHeight, err := utils.GetCurrentHeight(bin)
+	if err != nil {
+		return errors.Wrap(err, "error getting current height")
+	}
+
+	// NOTE: the module versions are stored to be compared with the upgraded state in `upgrade verify`
+	if !bin.Config.DryRun {
+		if err = SnapshotModuleVersions(bin, journal.TargetVersion); err != nil {
+			bin.Logger.Warn().Msgf("could not store module versions before upgrade: %v", err)
+		}
+	}
+
+	proposal, plan, err := gov.FindPendingUpgradeProposal(bin, journal.TargetVersion)
+	if err != nil {
+		return errors.Wrap(err, "error looking for pending upgrade proposals")
+	}
+
+	if proposal != nil && int(plan.Height) > currentHeight {
+		bin.Logger.Info().Msgf(
+			"reusing pending proposal %d for upgrade to %s at height %d",
+			proposal.Id, journal.TargetVersion, plan.Height,
+		)
+
+		journal.ProposalID = int(proposal.Id)
+		journal.UpgradeHeight = int(plan.Height)
+		// NOTE: a proposal in the voting period does not need any further deposits
+		journal.Deposited = proposal.Status == govv1types.StatusVotingPeriod
+
+		return journal.Save(bin)
+	}
+
+	upgradeHeight := currentHeight + utils.DeltaHeight
+
+	bin.Logger.Info().Msg("submitting upgrade proposal...")
+
+	proposalID, err := gov.SubmitUpgradeProposal(bin, journal.TargetVersion, upgradeHeight)
+	if err != nil {
+		return errors.Wrap(err, "error executing upgrade proposal")
+	}
+
+	bin.Logger.Info().Msgf("scheduled upgrade to %s at height %d.\n", journal.TargetVersion, upgradeHeight)
+
+	journal.ProposalID = proposalID
+	journal.UpgradeHeight = upgradeHeight
+
+	return journal.Save(bin)
+}
+
+// depositForProposal deposits the minimum deposit for the journal's proposal.
+func depositForProposal(bin *utils.Binary, journal *Journal) error {
+	deposit, err := gov.GetMinDeposit(bin)
+	if err != nil {
+		return errors.Wrap(err, "failed to get minimum deposit")
+	}
+
+	sender, err := bin.GetSender()
+	if err != nil {
+		return err
+	}
+
+	txHash, err := gov.DepositForProposal(bin, journal.ProposalID, sender.Name, deposit.String())
+	if err != nil {
+		return errors.Wrapf(err, "error depositing for proposal %d", journal.ProposalID)
+	}
+
+	journal.Deposited = true
+	journal.DepositTxHash = txHash
+
+	return journal.Save(bin)
+}
+
+// checkUpgradeHeight checks that the given upgrade height has not been reached yet.
+func checkUpgradeHeight(bin *utils.Binary, upgradeHeight int) error {
+	currentHeight, err := utils.GetCurrentHeight(bin)
+	if err != nil {
+		return errors.Wrap(err, "error getting current height")
+	}
+
+	if currentHeight >= upgradeHeight {
+		return fmt.Errorf("upgrade height %d has already been reached; current height: %d", upgradeHeight, currentHeight)
+	}
+
+	return nil
+}
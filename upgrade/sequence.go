@@ -0,0 +1,197 @@
+package upgrade
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/MalteHerrmann/evmos-utils/node"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// haltTimeout is the maximum time to wait for the node to halt at the upgrade height.
+	haltTimeout = 2 * time.Minute
+	// startTimeout is the maximum time to wait for the node to be reachable after a restart.
+	startTimeout = time.Minute
+)
+
+// Step is a single upgrade in a sequence of upgrades.
+type Step struct {
+	// Name is the name of the upgrade plan. If empty, the version is used.
+	Name string `yaml:"name"`
+	// Version is the version of the binary after the upgrade, which is checked against
+	// the output of the binary's version command.
+	Version string `yaml:"version"`
+	// Binary is the path to the binary, that is used after the upgrade,
+	// or the reference of a registered binary, e.g. "evmosd@v16.0.3".
+	Binary string `yaml:"binary"`
+}
+
+// LoadSequence reads the sequence of upgrade steps from the YAML file at the given path.
+func LoadSequence(path string) ([]Step, error) {
+	//#nosec G304 // path is explicitly passed by the user
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading sequence file")
+	}
+
+	return ParseSequence(bz)
+}
+
+// ParseSequence parses the sequence of upgrade steps from the given YAML contents.
+func ParseSequence(bz []byte) ([]Step, error) {
+	var steps []Step
+
+	if err := yaml.Unmarshal(bz, &steps); err != nil {
+		return nil, fmt.Errorf("error unmarshalling sequence: %w", err)
+	}
+
+	if len(steps) == 0 {
+		return nil, errors.New("sequence does not contain any steps")
+	}
+
+	for i := range steps {
+		if steps[i].Version == "" {
+			return nil, fmt.Errorf("step %d: missing version", i+1)
+		}
+
+		if steps[i].Binary == "" {
+			return nil, fmt.Errorf("step %d: missing binary", i+1)
+		}
+
+		if steps[i].Name == "" {
+			steps[i].Name = steps[i].Version
+		}
+	}
+
+	return steps, nil
+}
+
+// RunSequence runs the given upgrade steps in order. For each step, the upgrade proposal
+// is submitted and voted on, the node is stopped once it halted at the upgrade height,
+// restarted with the step's binary and the applied upgrade is verified.
+// The sequence stops at the first failing step.
+//
+// NOTE: the node process has to be controlled by the tool. If no node is running,
+// it is started using the configured binary.
+func RunSequence(bin *utils.Binary, steps []Step, nBlocks int) error {
+	if err := ensureNodeRunning(bin); err != nil {
+		return err
+	}
+
+	for i, step := range steps {
+		bin.Logger.Info().Msgf("step %d/%d: upgrading to %s using %s", i+1, len(steps), step.Name, step.Binary)
+
+		if err := runStep(bin, step, nBlocks); err != nil {
+			return errors.Wrapf(err, "step %d/%d (%s) failed", i+1, len(steps), step.Name)
+		}
+
+		bin.Logger.Info().Msgf("step %d/%d: successfully upgraded to %s", i+1, len(steps), step.Name)
+	}
+
+	return nil
+}
+
+// runStep runs the proposal, halt, swap, restart and verification cycle for a single upgrade step.
+func runStep(bin *utils.Binary, step Step, nBlocks int) error {
+	binary, err := utils.ResolveBinary(step.Binary)
+	if err != nil {
+		return err
+	}
+
+	step.Binary = binary
+
+	if err = checkBinaryVersion(step); err != nil {
+		return err
+	}
+
+	upgradeHeight, err := Prepare(bin, step.Name, false)
+	if err != nil {
+		return errors.Wrap(err, "error preparing upgrade")
+	}
+
+	bin.Logger.Info().Msgf("waiting for the node to halt at height %d", upgradeHeight)
+
+	if err = WaitForHalt(bin, upgradeHeight); err != nil {
+		return err
+	}
+
+	bin.Logger.Info().Msgf("restarting node with %s", step.Binary)
+
+	bin.Config.Appd = step.Binary
+
+	if _, err = node.Start(bin); err != nil {
+		return errors.Wrap(err, "error restarting node")
+	}
+
+	if err = node.WaitForRPC(bin, startTimeout); err != nil {
+		return err
+	}
+
+	report := Verify(bin, step.Name, nBlocks)
+	bin.Logger.Info().Msg(report.String())
+
+	if !report.Passed {
+		return errors.New("verification of applied upgrade failed")
+	}
+
+	return nil
+}
+
+// ensureNodeRunning makes sure that a node process controlled by the tool is running.
+// If no node is running, it is started using the configured binary.
+func ensureNodeRunning(bin *utils.Binary) error {
+	if _, running := node.IsRunning(bin); running {
+		return nil
+	}
+
+	if _, err := utils.GetCurrentHeight(bin); err == nil {
+		return fmt.Errorf("node at %s is not controlled by evmos-utils; please stop it first", bin.Config.Node)
+	}
+
+	bin.Logger.Info().Msgf("starting node using %s", bin.Config.Appd)
+
+	if _, err := node.Start(bin); err != nil {
+		return errors.Wrap(err, "error starting node")
+	}
+
+	return node.WaitForRPC(bin, startTimeout)
+}
+
+// WaitForHalt waits until the node has reached the last block before the given upgrade height
+// and then stops the node process.
+func WaitForHalt(bin *utils.Binary, upgradeHeight int) error {
+	if err := node.WaitForHalt(bin, upgradeHeight-1, haltTimeout); err != nil {
+		return errors.Wrap(err, "error waiting for upgrade height")
+	}
+
+	// NOTE: the node panics in the begin blocker of the upgrade height and stops producing blocks,
+	// so we give it some time to exit on its own before stopping it.
+	if !node.WaitForExit(bin, 10*time.Second) {
+		bin.Logger.Debug().Msg("node did not exit on its own after halting")
+	}
+
+	if err := node.Stop(bin); err != nil {
+		return errors.Wrap(err, "error stopping node")
+	}
+
+	return nil
+}
+
+// checkBinaryVersion checks that the step's binary reports the expected version.
+func checkBinaryVersion(step Step) error {
+	version, err := utils.GetBinaryVersion(step.Binary)
+	if err != nil {
+		return err
+	}
+
+	if strings.TrimPrefix(version, "v") != strings.TrimPrefix(step.Version, "v") {
+		return fmt.Errorf("binary %s has version %s; expected %s", step.Binary, version, step.Version)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package upgrade_test
+
+import (
+	"testing"
+
+	"github.com/MalteHerrmann/evmos-utils/upgrade"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSequence(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		spec        string
+		expSteps    []upgrade.Step
+		expError    bool
+		errContains string
+	}{
+		{
+			name: "pass",
+			spec: `
+- name: v16.0.0
+  version: 16.0.0
+  binary: /bin/evmosd-v16
+- version: v17.0.0
+  binary: /bin/evmosd-v17
+`,
+			expSteps: []upgrade.Step{
+				{Name: "v16.0.0", Version: "16.0.0", Binary: "/bin/evmosd-v16"},
+				{Name: "v17.0.0", Version: "v17.0.0", Binary: "/bin/evmosd-v17"},
+			},
+		},
+		{
+			name:        "fail - empty sequence",
+			spec:        "[]",
+			expError:    true,
+			errContains: "sequence does not contain any steps",
+		},
+		{
+			name:        "fail - missing binary",
+			spec:        "- version: v16.0.0",
+			expError:    true,
+			errContains: "step 1: missing binary",
+		},
+		{
+			name:        "fail - invalid yaml",
+			spec:        "invalid: [",
+			expError:    true,
+			errContains: "error unmarshalling sequence",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			steps, err := upgrade.ParseSequence([]byte(tc.spec))
+			if tc.expError {
+				require.Error(t, err, "expected error parsing sequence")
+				require.ErrorContains(t, err, tc.errContains, "expected different error")
+			} else {
+				require.NoError(t, err, "unexpected error parsing sequence")
+				require.Equal(t, tc.expSteps, steps, "expected different steps")
+			}
+		})
+	}
+}
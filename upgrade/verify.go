@@ -0,0 +1,229 @@
+package upgrade
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/pkg/errors"
+)
+
+// blockTimeout is the maximum time to wait for a single block when verifying
+// that the chain is producing blocks after the upgrade.
+const blockTimeout = 30 * time.Second
+
+// CheckResult is the result of a single check that is run during the verification
+// of an applied upgrade.
+type CheckResult struct {
+	Name    string `json:"name"`
+	Passed  bool   `json:"passed"`
+	Details string `json:"details"`
+}
+
+// Report contains the results of all checks, that were run to verify an applied upgrade.
+type Report struct {
+	UpgradeName string        `json:"upgrade_name"`
+	Passed      bool          `json:"passed"`
+	Checks      []CheckResult `json:"checks"`
+}
+
+// String returns a human-readable representation of the report.
+func (r Report) String() string {
+	var builder strings.Builder
+
+	builder.WriteString(fmt.Sprintf("verification report for upgrade %s\n", r.UpgradeName))
+
+	for _, check := range r.Checks {
+		status := "PASS"
+		if !check.Passed {
+			status = "FAIL"
+		}
+
+		builder.WriteString(fmt.Sprintf("  [%s] %s: %s\n", status, check.Name, check.Details))
+	}
+
+	result := "PASSED"
+	if !r.Passed {
+		result = "FAILED"
+	}
+
+	builder.WriteString(fmt.Sprintf("result: %s\n", result))
+
+	return builder.String()
+}
+
+// JSON returns the report encoded as JSON.
+func (r Report) JSON() (string, error) {
+	bz, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return "", errors.Wrap(err, "error marshalling report")
+	}
+
+	return string(bz), nil
+}
+
+// Verify runs the post-upgrade checks for the upgrade with the given name and returns
+// the corresponding report. It checks that the upgrade was applied at a given height,
+// that the module versions moved forward compared to the snapshot taken before the
+// upgrade proposal and that the chain produces the given number of new blocks.
+func Verify(bin *utils.Binary, upgradeName string, nBlocks int) Report {
+	report := Report{UpgradeName: upgradeName}
+
+	report.Checks = append(report.Checks,
+		checkAppliedHeight(bin, upgradeName),
+		checkModuleVersions(bin, upgradeName),
+		checkBlockProduction(bin, nBlocks),
+	)
+
+	report.Passed = true
+
+	for _, check := range report.Checks {
+		if !check.Passed {
+			report.Passed = false
+		}
+	}
+
+	return report
+}
+
+// checkAppliedHeight checks that the upgrade with the given name was applied on chain.
+func checkAppliedHeight(bin *utils.Binary, upgradeName string) CheckResult {
+	result := CheckResult{Name: "applied height"}
+
+	height, err := QueryAppliedHeight(bin, upgradeName)
+	if err != nil {
+		result.Details = err.Error()
+
+		return result
+	}
+
+	result.Passed = true
+	result.Details = fmt.Sprintf("upgrade applied at height %d", height)
+
+	return result
+}
+
+// checkModuleVersions compares the current module versions with the snapshot that was
+// taken before the upgrade was scheduled.
+func checkModuleVersions(bin *utils.Binary, upgradeName string) CheckResult {
+	before, err := LoadModuleVersionsSnapshot(bin, upgradeName)
+	if err != nil {
+		return CheckResult{Name: "module versions", Details: err.Error()}
+	}
+
+	after, err := QueryModuleVersions(bin)
+	if err != nil {
+		return CheckResult{Name: "module versions", Details: err.Error()}
+	}
+
+	return EvaluateModuleVersionChanges(DiffModuleVersions(before, after))
+}
+
+// EvaluateModuleVersionChanges returns the result of the module versions check for the given changes.
+// The check passes if at least one module version was increased or a module was added,
+// and no module version was decreased. Removed modules are only reported.
+func EvaluateModuleVersionChanges(changes []ModuleVersionChange) CheckResult {
+	result := CheckResult{Name: "module versions"}
+
+	if len(changes) == 0 {
+		result.Details = "no module versions changed"
+
+		return result
+	}
+
+	var (
+		movedForward bool
+		decreased    bool
+		descriptions = make([]string, 0, len(changes))
+	)
+
+	for _, change := range changes {
+		switch {
+		case change.After == 0:
+			descriptions = append(descriptions, fmt.Sprintf("%s removed (was %d)", change.Module, change.Before))
+
+			continue
+		case change.After > change.Before:
+			movedForward = true
+		default:
+			decreased = true
+		}
+
+		descriptions = append(descriptions, fmt.Sprintf("%s %d -> %d", change.Module, change.Before, change.After))
+	}
+
+	result.Passed = movedForward && !decreased
+	result.Details = strings.Join(descriptions, ", ")
+
+	if !movedForward {
+		result.Details += "; no module version was increased"
+	}
+
+	return result
+}
+
+// checkBlockProduction checks that the chain produces the given number of new blocks.
+func checkBlockProduction(bin *utils.Binary, nBlocks int) CheckResult {
+	result := CheckResult{Name: "block production"}
+
+	startHeight, err := utils.GetCurrentHeight(bin)
+	if err != nil {
+		result.Details = err.Error()
+
+		return result
+	}
+
+	timeout := time.Duration(nBlocks) * blockTimeout
+	if err = utils.WaitForHeight(bin, startHeight+nBlocks, timeout); err != nil {
+		result.Details = err.Error()
+
+		return result
+	}
+
+	result.Passed = true
+	result.Details = fmt.Sprintf("produced %d new blocks after height %d", nBlocks, startHeight)
+
+	return result
+}
+
+// QueryAppliedHeight returns the height at which the upgrade with the given name was applied.
+func QueryAppliedHeight(bin *utils.Binary, upgradeName string) (int, error) {
+	out, err := utils.ExecuteQuery(bin, utils.QueryArgs{
+		Subcommand: []string{"q", "upgrade", "applied", upgradeName, "--output=json"},
+		Quiet:      true,
+	})
+	if err != nil {
+		if strings.Contains(out, "no upgrade found") {
+			return 0, fmt.Errorf("upgrade %s has not been applied", upgradeName)
+		}
+
+		return 0, errors.Wrapf(err, "error querying applied upgrade %s", upgradeName)
+	}
+
+	return ParseAppliedHeightFromResponse(out)
+}
+
+// ParseAppliedHeightFromResponse parses the height from the block header, that is returned
+// by the query for an applied upgrade plan.
+//
+// NOTE: The header is encoded using amino JSON, so we use a regex to extract the height
+// similar to utils.GetCurrentHeight.
+func ParseAppliedHeightFromResponse(out string) (int, error) {
+	heightPattern := regexp.MustCompile(`(?s)"header":\s*{.*?"height":\s*"(\d+)"`)
+
+	match := heightPattern.FindStringSubmatch(out)
+	if len(match) < 2 {
+		return 0, fmt.Errorf("did not find applied height in output: %q", out)
+	}
+
+	height, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0, fmt.Errorf("error converting height to integer: %w", err)
+	}
+
+	return height, nil
+}
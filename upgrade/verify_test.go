@@ -0,0 +1,116 @@
+package upgrade_test
+
+import (
+	"testing"
+
+	"github.com/MalteHerrmann/evmos-utils/upgrade"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAppliedHeightFromResponse(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		out         string
+		expHeight   int
+		expError    bool
+		errContains string
+	}{
+		{
+			name: "pass",
+			out: `{
+  "block_id": {"hash": "A1B2", "parts": {"total": 1, "hash": "C3D4"}},
+  "block_size": "1234",
+  "header": {
+    "version": {"block": "11", "app": "0"},
+    "chain_id": "evmos_9000-1",
+    "height": "151",
+    "time": "2023-08-23T21:16:24Z"
+  },
+  "num_txs": "0"
+}`,
+			expHeight: 151,
+		},
+		{
+			name:        "fail - no height",
+			out:         "invalid output",
+			expError:    true,
+			errContains: "did not find applied height in output",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			height, err := upgrade.ParseAppliedHeightFromResponse(tc.out)
+			if tc.expError {
+				require.Error(t, err, "expected error parsing applied height")
+				require.ErrorContains(t, err, tc.errContains, "expected different error")
+			} else {
+				require.NoError(t, err, "unexpected error parsing applied height")
+				require.Equal(t, tc.expHeight, height, "expected different applied height")
+			}
+		})
+	}
+}
+
+func TestEvaluateModuleVersionChanges(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name       string
+		changes    []upgrade.ModuleVersionChange
+		expPassed  bool
+		expDetails string
+	}{
+		{
+			name: "pass - version increased and module added",
+			changes: []upgrade.ModuleVersionChange{
+				{Module: "bank", Before: 3, After: 4},
+				{Module: "erc20", Before: 0, After: 3},
+			},
+			expPassed:  true,
+			expDetails: "bank 3 -> 4, erc20 0 -> 3",
+		},
+		{
+			name: "pass - removed module is only reported",
+			changes: []upgrade.ModuleVersionChange{
+				{Module: "bank", Before: 3, After: 4},
+				{Module: "claims", Before: 2, After: 0},
+			},
+			expPassed:  true,
+			expDetails: "bank 3 -> 4, claims removed (was 2)",
+		},
+		{
+			name:       "fail - no changes",
+			expDetails: "no module versions changed",
+		},
+		{
+			name: "fail - only removed modules",
+			changes: []upgrade.ModuleVersionChange{
+				{Module: "claims", Before: 2, After: 0},
+			},
+			expDetails: "claims removed (was 2); no module version was increased",
+		},
+		{
+			name: "fail - version decreased",
+			changes: []upgrade.ModuleVersionChange{
+				{Module: "bank", Before: 3, After: 4},
+				{Module: "evm", Before: 5, After: 4},
+			},
+			expDetails: "bank 3 -> 4, evm 5 -> 4",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			result := upgrade.EvaluateModuleVersionChanges(tc.changes)
+			require.Equal(t, tc.expPassed, result.Passed, "expected different check result")
+			require.Equal(t, tc.expDetails, result.Details, "expected different details")
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// validatorPrefixSuffix is appended to the bech32 prefix of account addresses
+// to get the prefix of validator operator addresses, e.g. "evmosvaloper".
+const validatorPrefixSuffix = "valoper"
+
+// Addresses are the different encodings of the same address.
+type Addresses struct {
+	// Bech32 is the bech32 encoded account address, e.g. "evmos1...".
+	Bech32 string `json:"bech32"`
+	// Hex is the EIP-55 encoded hex address, e.g. "0x...".
+	Hex string `json:"hex"`
+	// Validator is the bech32 encoded validator operator address, e.g. "evmosvaloper1...".
+	Validator string `json:"validator"`
+}
+
+// String returns the addresses in a human-readable format.
+func (a Addresses) String() string {
+	return fmt.Sprintf("bech32:     %s\nhex:        %s\nvalidator:  %s", a.Bech32, a.Hex, a.Validator)
+}
+
+// JSON returns the addresses in JSON format.
+func (a Addresses) JSON() (string, error) {
+	bz, err := json.MarshalIndent(a, "", "  ")
+	if err != nil {
+		return "", errors.Wrap(err, "error marshalling addresses")
+	}
+
+	return string(bz), nil
+}
+
+// ParseAddress returns the bytes of the given hex address or bech32 address with any prefix.
+func ParseAddress(address string) ([]byte, error) {
+	if common.IsHexAddress(address) {
+		return common.HexToAddress(address).Bytes(), nil
+	}
+
+	if strings.HasPrefix(address, "0x") {
+		return nil, fmt.Errorf("invalid hex address %q", address)
+	}
+
+	_, bz, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address %q: %w", address, err)
+	}
+
+	return bz, nil
+}
+
+// GetAddresses returns the encodings of the given address bytes,
+// using the given bech32 prefix for account addresses.
+func GetAddresses(bz []byte, prefix string) (Addresses, error) {
+	bech32Address, err := bech32.ConvertAndEncode(prefix, bz)
+	if err != nil {
+		return Addresses{}, errors.Wrap(err, "error encoding bech32 address")
+	}
+
+	validatorAddress, err := bech32.ConvertAndEncode(prefix+validatorPrefixSuffix, bz)
+	if err != nil {
+		return Addresses{}, errors.Wrap(err, "error encoding validator address")
+	}
+
+	return Addresses{
+		Bech32:    bech32Address,
+		Hex:       common.BytesToAddress(bz).Hex(),
+		Validator: validatorAddress,
+	}, nil
+}
+
+// ConvertAddress returns the encodings of the given hex or bech32 address,
+// using the given bech32 prefix for account addresses.
+func ConvertAddress(address, prefix string) (Addresses, error) {
+	bz, err := ParseAddress(address)
+	if err != nil {
+		return Addresses{}, err
+	}
+
+	return GetAddresses(bz, prefix)
+}
+
+// Bytes returns the bytes of the account address.
+func (acc Account) Bytes() ([]byte, error) {
+	return ParseAddress(acc.Address)
+}
+
+// Addresses returns the encodings of the account address.
+func (acc Account) Addresses() (Addresses, error) {
+	bz, err := acc.Bytes()
+	if err != nil {
+		return Addresses{}, err
+	}
+
+	return GetAddresses(bz, Bech32Prefix)
+}
+
+// ValidatorAddress returns the validator operator address of the account, e.g. "evmosvaloper1...".
+func (acc Account) ValidatorAddress() (string, error) {
+	addresses, err := acc.Addresses()
+	if err != nil {
+		return "", err
+	}
+
+	return addresses.Validator, nil
+}
+
+// ResolveAddress returns the bech32 account address for the given key name of the keyring,
+// hex address or bech32 address with any prefix, e.g. a validator operator address.
+func (bin *Binary) ResolveAddress(keyOrAddress string) (string, error) {
+	if acc, err := FindAccount(bin.Accounts, keyOrAddress); err == nil {
+		return acc.Address, nil
+	}
+
+	addresses, err := ConvertAddress(keyOrAddress, Bech32Prefix)
+	if err != nil {
+		return "", fmt.Errorf("%q is neither a key in the keyring nor a valid address", keyOrAddress)
+	}
+
+	return addresses.Bech32, nil
+}
@@ -0,0 +1,99 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertAddress(t *testing.T) {
+	t.Parallel()
+
+	hexAddress := "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+
+	addresses, err := utils.ConvertAddress(hexAddress, utils.Bech32Prefix)
+	require.NoError(t, err, "expected no error converting hex address")
+	require.Equal(t, hexAddress, addresses.Hex, "expected checksummed hex address")
+	require.Regexp(t, "^evmos1", addresses.Bech32, "expected bech32 account address")
+	require.Regexp(t, "^evmosvaloper1", addresses.Validator, "expected validator operator address")
+
+	testcases := []struct {
+		name        string
+		address     string
+		prefix      string
+		expError    bool
+		errContains string
+	}{
+		{
+			name:    "pass - lowercase hex address",
+			address: "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+			prefix:  utils.Bech32Prefix,
+		},
+		{
+			name:    "pass - bech32 address",
+			address: addresses.Bech32,
+			prefix:  utils.Bech32Prefix,
+		},
+		{
+			name:    "pass - validator operator address",
+			address: addresses.Validator,
+			prefix:  utils.Bech32Prefix,
+		},
+		{
+			name:    "pass - other prefix",
+			address: addresses.Bech32,
+			prefix:  "cosmos",
+		},
+		{
+			name:        "fail - invalid hex address",
+			address:     "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeA",
+			expError:    true,
+			errContains: "invalid hex address",
+		},
+		{
+			name:        "fail - invalid bech32 address",
+			address:     "evmos1invalid",
+			expError:    true,
+			errContains: "invalid address",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			converted, err := utils.ConvertAddress(tc.address, tc.prefix)
+			if tc.expError {
+				require.ErrorContains(t, err, tc.errContains, "expected different error")
+
+				return
+			}
+
+			require.NoError(t, err, "expected no error converting address")
+			require.Equal(t, hexAddress, converted.Hex, "expected the same hex address")
+
+			back, err := utils.ConvertAddress(converted.Bech32, utils.Bech32Prefix)
+			require.NoError(t, err, "expected no error converting back")
+			require.Equal(t, addresses, back, "expected the same addresses after converting back")
+		})
+	}
+}
+
+func TestResolveAddress(t *testing.T) {
+	t.Parallel()
+
+	addresses, err := utils.ConvertAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", utils.Bech32Prefix)
+	require.NoError(t, err, "expected no error converting address")
+
+	bin := &utils.Binary{Accounts: []utils.Account{{Name: "dev0", Address: addresses.Bech32, HexAddress: addresses.Hex}}}
+
+	for _, input := range []string{"dev0", addresses.Bech32, addresses.Hex, addresses.Validator} {
+		resolved, err := bin.ResolveAddress(input)
+		require.NoError(t, err, "expected no error resolving %s", input)
+		require.Equal(t, addresses.Bech32, resolved, "expected bech32 address for %s", input)
+	}
+
+	_, err = bin.ResolveAddress("dev1")
+	require.ErrorContains(t, err, "neither a key in the keyring nor a valid address")
+}
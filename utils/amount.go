@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// attoPrefix is the prefix of base denominations with 18 decimals, e.g. "aevmos".
+	attoPrefix = "a"
+	// attoDecimals is the number of decimals of base denominations with the atto prefix.
+	attoDecimals = 18
+)
+
+// ParseAmount parses the given amount into coins of the given base denomination.
+// Plain numbers are interpreted as amounts of the base denomination, while amounts of the
+// display denomination, e.g. "100evmos", are converted to the base denomination, e.g. "aevmos".
+// Amounts of other denominations are returned as is. Amounts, that end up in the same denomination
+// after the conversion, e.g. "1evmos,5aevmos", are added up.
+func ParseAmount(amount, baseDenom string) (sdk.Coins, error) {
+	amount = strings.TrimSpace(amount)
+	if _, ok := sdk.NewIntFromString(amount); ok {
+		amount += baseDenom
+	}
+
+	decCoins, err := sdk.ParseDecCoins(amount)
+	if err != nil {
+		return nil, fmt.Errorf("invalid amount %q: %w", amount, err)
+	}
+
+	coins := sdk.NewCoins()
+
+	for _, decCoin := range decCoins {
+		if attoPrefix+decCoin.Denom == baseDenom {
+			decCoin = sdk.NewDecCoinFromDec(
+				baseDenom, decCoin.Amount.Mul(sdk.NewDec(10).Power(attoDecimals)),
+			)
+		}
+
+		if !decCoin.Amount.IsInteger() {
+			return nil, fmt.Errorf("invalid amount %q: %s has too many decimals", amount, decCoin.Denom)
+		}
+
+		coins = coins.Add(sdk.NewCoin(decCoin.Denom, decCoin.Amount.TruncateInt()))
+	}
+
+	return coins, nil
+}
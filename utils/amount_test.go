@@ -0,0 +1,74 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAmount(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		amount      string
+		expCoins    string
+		expError    bool
+		errContains string
+	}{
+		{
+			name:     "pass - plain number",
+			amount:   "1000",
+			expCoins: "1000aevmos",
+		},
+		{
+			name:     "pass - display denomination",
+			amount:   "100evmos",
+			expCoins: "100000000000000000000aevmos",
+		},
+		{
+			name:     "pass - decimal display denomination",
+			amount:   "0.5evmos",
+			expCoins: "500000000000000000aevmos",
+		},
+		{
+			name:     "pass - other denominations",
+			amount:   "1evmos,10uatom",
+			expCoins: "1000000000000000000aevmos,10uatom",
+		},
+		{
+			name:     "pass - display and base denomination",
+			amount:   "1evmos,5aevmos",
+			expCoins: "1000000000000000005aevmos",
+		},
+		{
+			name:        "fail - decimal base denomination",
+			amount:      "0.5aevmos",
+			expError:    true,
+			errContains: "too many decimals",
+		},
+		{
+			name:        "fail - invalid amount",
+			amount:      "evmos",
+			expError:    true,
+			errContains: "invalid amount",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			coins, err := utils.ParseAmount(tc.amount, "aevmos")
+			if tc.expError {
+				require.ErrorContains(t, err, tc.errContains, "expected different error")
+
+				return
+			}
+
+			require.NoError(t, err, "expected no error parsing amount")
+			require.Equal(t, tc.expCoins, coins.String(), "expected different coins")
+		})
+	}
+}
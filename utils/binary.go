@@ -28,6 +28,12 @@ type Binary struct {
 
 	// Logger is a logger to be used within all commands.
 	Logger zerolog.Logger
+
+	// dryRunScriptStarted is true once the first command was written to the dry-run script.
+	dryRunScriptStarted bool
+	// keyringPassphrase is the passphrase of the keyring, that is passed to the binary and
+	// used to open the keyring. If empty, the passphrase is prompted for.
+	keyringPassphrase string
 }
 
 // BinaryConfig holds the configuration of the binary.
@@ -38,35 +44,74 @@ type BinaryConfig struct {
 	ChainID string
 	// Denom for the fee payments on transactions
 	Denom string
+	// DryRun defines whether transactions are only printed instead of being broadcast.
+	DryRun bool
+	// DryRunScript is the path of the shell script, that the planned transactions
+	// are written to in dry-run mode. If empty, no script is written.
+	DryRunScript string
+	// Exclude are the names, glob patterns of names or addresses of the keys, that are not used
+	// to sign transactions.
+	Exclude []string
+	// FeeGranter is the name or address of the key, that pays the fees of all transactions
+	// through a fee allowance. When the accounts are loaded, key names are resolved to the address.
+	FeeGranter string
+	// From is the name or address of the key, that is used to sign transactions.
+	// If empty, the first of the selected keys is used.
+	From string
 	// Home is the home directory of the binary.
 	Home string
+	// Keys are the names, glob patterns of names or addresses of the keys, that are used
+	// to sign transactions. If empty, all keys are used.
+	Keys []string
 	// KeyringBackend defines which keyring to use
 	KeyringBackend string
+	// KeyringPassphraseFile is the path of a file containing the passphrase of the keyring.
+	// If empty, the passphrase is read from the environment variable KeyringPassphraseEnv.
+	KeyringPassphraseFile string
 	// Node is the endpoint for gRPC connections
 	Node string
 }
 
 // NewBinary returns a new Binary instance.
 func NewBinary(config BinaryConfig) (*Binary, error) {
-	userHome, err := os.UserHomeDir()
+	binary, err := NewUninitializedBinary(config)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get user home dir")
+		return nil, err
 	}
 
-	// strip the home directory from the given home if already included
-	var homeDir string
-	if strings.Contains(config.Home, userHome) {
-		homeDir = config.Home
-	} else {
-		homeDir = filepath.Join(userHome, config.Home)
+	if _, err = os.Stat(binary.Config.Home); os.IsNotExist(err) {
+		return nil, errors.Wrap(err, "home directory does not exist: "+binary.Config.Home)
+	}
+
+	if err = binary.getAccounts(); err != nil {
+		return nil, err
+	}
+
+	if binary.Config.FeeGranter != "" {
+		if binary.Config.FeeGranter, err = binary.ResolveAddress(binary.Config.FeeGranter); err != nil {
+			return nil, errors.Wrap(err, "error resolving fee granter")
+		}
 	}
 
-	if _, err = os.Stat(homeDir); os.IsNotExist(err) {
-		return nil, errors.Wrap(err, "home directory does not exist: "+homeDir)
+	return binary, nil
+}
+
+// NewUninitializedBinary returns a new Binary instance for a home directory,
+// that does not need to exist yet, e.g. because the node is about to be initialized.
+// The accounts of the keyring are not loaded.
+func NewUninitializedBinary(config BinaryConfig) (*Binary, error) {
+	homeDir, err := GetHomeDir(config.Home)
+	if err != nil {
+		return nil, err
 	}
 
 	config.Home = homeDir
 
+	// resolve references to registered binaries, e.g. "evmosd@v16.0.3"
+	if config.Appd, err = ResolveBinary(config.Appd); err != nil {
+		return nil, err
+	}
+
 	// check if binary is installed
 	if _, err = exec.LookPath(config.Appd); err != nil {
 		return nil, fmt.Errorf("binary %q not installed", config.Appd)
@@ -74,22 +119,41 @@ func NewBinary(config BinaryConfig) (*Binary, error) {
 
 	cdc, ok := GetCodec()
 	if !ok {
-		return nil, errors.Wrap(err, "failed to get codec")
+		return nil, errors.New("failed to get codec")
+	}
+
+	passphrase, err := GetKeyringPassphrase(config.KeyringPassphraseFile)
+	if err != nil {
+		return nil, err
 	}
 
-	logger := log.Output(zerolog.ConsoleWriter{Out: os.Stdout})
+	return &Binary{
+		Cdc:               cdc,
+		Config:            config,
+		Logger:            NewLogger(),
+		keyringPassphrase: passphrase,
+	}, nil
+}
+
+// NewLogger returns the logger to be used within all commands.
+func NewLogger() zerolog.Logger {
+	return log.Output(zerolog.ConsoleWriter{Out: os.Stdout})
+}
 
-	binary := &Binary{
-		Cdc:    cdc,
-		Config: config,
-		Logger: logger,
+// GetHomeDir returns the full path of the given home directory.
+// Relative paths are interpreted relative to the user's home directory.
+func GetHomeDir(home string) (string, error) {
+	userHome, err := os.UserHomeDir()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get user home dir")
 	}
 
-	if err = binary.getAccounts(); err != nil {
-		return nil, err
+	// strip the home directory from the given home if already included
+	if strings.Contains(home, userHome) {
+		return home, nil
 	}
 
-	return binary, nil
+	return filepath.Join(userHome, home), nil
 }
 
 // GetCodec returns the codec to be used for the client.
@@ -99,3 +163,25 @@ func GetCodec() (*codec.ProtoCodec, bool) {
 
 	return protoCodec, ok
 }
+
+// GetBinaryVersion returns the version reported by the given binary.
+func GetBinaryVersion(appd string) (string, error) {
+	return runVersionCommand(appd)
+}
+
+// GetBinaryVersionInfo returns the detailed version information reported by the given binary.
+func GetBinaryVersionInfo(appd string) (string, error) {
+	return runVersionCommand(appd, "--long")
+}
+
+// runVersionCommand executes the version command of the given binary with the given flags
+// and returns its trimmed output.
+func runVersionCommand(appd string, flags ...string) (string, error) {
+	//#nosec G204 // binary path is explicitly passed by the user
+	out, err := exec.Command(appd, append([]string{"version"}, flags...)...).CombinedOutput()
+	if err != nil {
+		return "", errors.Wrapf(err, "error getting version of %s", appd)
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
@@ -5,4 +5,9 @@ const (
 	defaultFees int = 1e16 // 0.01 evmos
 	// DeltaHeight is the amount of blocks in the future that the upgrade will be scheduled.
 	DeltaHeight = 20
+	// TestnetManifestFile is the name of the file in the home directory of a local testnet,
+	// which lists the nodes of the testnet.
+	TestnetManifestFile = "testnet.json"
+	// Bech32Prefix is the prefix of the bech32 encoded account addresses.
+	Bech32Prefix = "evmos"
 )
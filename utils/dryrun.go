@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// recordDryRunTx prints the given transaction command instead of executing it
+// and appends it to the configured dry-run script, if any.
+func recordDryRunTx(bin *Binary, txCommand []string) error {
+	command := BuildShellCommand(append([]string{bin.Config.Appd}, txCommand...))
+
+	bin.Logger.Info().Msgf("dry-run: %s", command)
+
+	if bin.Config.DryRunScript == "" {
+		return nil
+	}
+
+	flags := os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	if !bin.dryRunScriptStarted {
+		flags = os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	}
+
+	//#nosec G302 G304 // script has to be executable and the path is explicitly passed by the user
+	file, err := os.OpenFile(bin.Config.DryRunScript, flags, 0o700)
+	if err != nil {
+		return errors.Wrap(err, "error opening dry-run script")
+	}
+
+	content := command + "\n"
+	if !bin.dryRunScriptStarted {
+		content = "#!/usr/bin/env bash\nset -e\n\n" + content
+		bin.dryRunScriptStarted = true
+	}
+
+	_, err = file.WriteString(content)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "error writing dry-run script")
+	}
+
+	return nil
+}
+
+// BuildShellCommand joins the given arguments to a command, that can be executed in a shell.
+// Arguments containing special characters are quoted.
+func BuildShellCommand(args []string) string {
+	quoted := make([]string, len(args))
+
+	for i, arg := range args {
+		quoted[i] = shellQuote(arg)
+	}
+
+	return strings.Join(quoted, " ")
+}
+
+// shellQuote quotes the given argument for usage in a shell if necessary.
+func shellQuote(arg string) string {
+	if arg != "" && !strings.ContainsAny(arg, " \t\n'\"\\$`!*?&;|<>()[]{}#~") {
+		return arg
+	}
+
+	return fmt.Sprintf("'%s'", strings.ReplaceAll(arg, "'", `'"'"'`))
+}
@@ -0,0 +1,66 @@
+package utils_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildShellCommand(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name       string
+		args       []string
+		expCommand string
+	}{
+		{
+			name:       "pass - no quoting needed",
+			args:       []string{"evmosd", "tx", "gov", "vote", "1", "yes", "--fees", "10000aevmos"},
+			expCommand: "evmosd tx gov vote 1 yes --fees 10000aevmos",
+		},
+		{
+			name:       "pass - quote spaces and single quotes",
+			args:       []string{"evmosd", "--title", "'Upgrade to v17.0.0'", ""},
+			expCommand: `evmosd --title ''"'"'Upgrade to v17.0.0'"'"'' ''`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.expCommand, utils.BuildShellCommand(tc.args), "expected different command")
+		})
+	}
+}
+
+func TestExecuteTxFeeGranter(t *testing.T) {
+	t.Parallel()
+
+	script := filepath.Join(t.TempDir(), "plan.sh")
+	bin := &utils.Binary{Config: utils.BinaryConfig{
+		Appd:           "evmosd",
+		Denom:          "aevmos",
+		DryRun:         true,
+		DryRunScript:   script,
+		FeeGranter:     "evmos1vdyc8y8d7n5zjstmmnwwnpsqtsyz52pc42rs54",
+		KeyringBackend: "test",
+		Node:           "http://localhost:26657",
+	}}
+
+	out, err := utils.ExecuteTx(bin, utils.TxArgs{
+		Subcommand: []string{"tx", "gov", "vote", "1", "yes"},
+		From:       "dev0",
+	})
+	require.NoError(t, err, "expected no error in dry-run mode")
+	require.Empty(t, out, "expected no output in dry-run mode")
+
+	bz, err := os.ReadFile(script)
+	require.NoError(t, err, "expected dry-run script to be written")
+	require.Contains(t, string(bz), "--fee-granter evmos1vdyc8y8d7n5zjstmmnwwnpsqtsyz52pc42rs54",
+		"expected fee granter to be attached")
+}
@@ -0,0 +1,129 @@
+package utils
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	cryptokeyring "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common"
+	evmoshd "github.com/evmos/evmos/v17/crypto/hd"
+	"github.com/pkg/errors"
+)
+
+// KeyringPassphraseEnv is the environment variable, that contains the passphrase of the keyring.
+// It is used if no passphrase file is configured.
+const KeyringPassphraseEnv = "EVMOS_UTILS_KEYRING_PASSPHRASE"
+
+// GetKeyringPassphrase returns the passphrase of the keyring. It is read from the given file
+// or, if no file is given, from the environment variable KeyringPassphraseEnv.
+// An empty passphrase is returned if neither is set.
+func GetKeyringPassphrase(passphraseFile string) (string, error) {
+	if passphraseFile == "" {
+		return os.Getenv(KeyringPassphraseEnv), nil
+	}
+
+	//#nosec G304 // passphrase file is explicitly passed by the user
+	bz, err := os.ReadFile(passphraseFile)
+	if err != nil {
+		return "", errors.Wrap(err, "error reading keyring passphrase file")
+	}
+
+	return strings.TrimRight(string(bz), "\r\n"), nil
+}
+
+// OpenKeyring opens the keyring in the given home directory with the configured backend.
+// It supports the Ethereum-compatible eth_secp256k1 keys used by Evmos.
+//
+// NOTE: the SDK ignores the given passphrase input and prompts for the passphrase instead,
+// if the standard input is a terminal. In an interactive shell, the configured passphrase is therefore
+// only used by the commands executed with the binary, while reading the keyring in-process prompts for it.
+func OpenKeyring(bin *Binary, home string) (cryptokeyring.Keyring, error) {
+	input := bin.getPassphraseInput()
+	if input == nil {
+		input = os.Stdin
+	}
+
+	kr, err := cryptokeyring.New(
+		sdk.KeyringServiceName(), bin.Config.KeyringBackend, home, input, bin.Cdc, evmoshd.EthSecp256k1Option(),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error opening %s keyring in %s", bin.Config.KeyringBackend, home)
+	}
+
+	return kr, nil
+}
+
+// ListAccounts returns the accounts stored in the keyring in the given home directory.
+func ListAccounts(bin *Binary, home string) ([]Account, error) {
+	kr, err := OpenKeyring(bin, home)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := kr.List()
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing keys in %s", home)
+	}
+
+	// NOTE: the records are sorted by name, so that the default sender does not depend on the backend
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Name < records[j].Name
+	})
+
+	accounts := make([]Account, 0, len(records))
+
+	for _, record := range records {
+		account, err := NewAccountFromRecord(bin, record)
+		if err != nil {
+			return nil, err
+		}
+
+		account.KeyringHome = home
+		accounts = append(accounts, account)
+	}
+
+	return accounts, nil
+}
+
+// NewAccountFromRecord returns the account for the given keyring record.
+func NewAccountFromRecord(bin *Binary, record *cryptokeyring.Record) (Account, error) {
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return Account{}, errors.Wrapf(err, "error getting public key of %s", record.Name)
+	}
+
+	pubKeyJSON, err := bin.Cdc.MarshalInterfaceJSON(pubKey)
+	if err != nil {
+		return Account{}, errors.Wrapf(err, "error encoding public key of %s", record.Name)
+	}
+
+	address, err := sdk.Bech32ifyAddressBytes(Bech32Prefix, pubKey.Address())
+	if err != nil {
+		return Account{}, fmt.Errorf("error encoding address of %s: %w", record.Name, err)
+	}
+
+	return Account{
+		Name:       record.Name,
+		Type:       record.GetType().String(),
+		Address:    address,
+		PubKey:     string(pubKeyJSON),
+		PubKeyType: "/" + proto.MessageName(pubKey),
+		HexAddress: common.BytesToAddress(pubKey.Address()).Hex(),
+	}, nil
+}
+
+// getPassphraseInput returns the input, that answers the passphrase prompts of the keyring.
+// If no passphrase is configured, nil is returned.
+func (bin *Binary) getPassphraseInput() io.Reader {
+	if bin.keyringPassphrase == "" {
+		return nil
+	}
+
+	// NOTE: the passphrase is passed twice, because creating a new keyring requires a confirmation
+	return strings.NewReader(strings.Repeat(bin.keyringPassphrase+"\n", 2))
+}
@@ -0,0 +1,60 @@
+package utils_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	cryptokeyring "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	evmoshd "github.com/evmos/evmos/v17/crypto/hd"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListAccounts(t *testing.T) {
+	t.Parallel()
+
+	cdc, ok := utils.GetCodec()
+	require.True(t, ok, "expected codec to be available")
+
+	home := t.TempDir()
+	bin := &utils.Binary{Cdc: cdc, Config: utils.BinaryConfig{KeyringBackend: cryptokeyring.BackendTest}}
+
+	kr, err := utils.OpenKeyring(bin, home)
+	require.NoError(t, err, "expected no error opening keyring")
+
+	_, _, err = kr.NewMnemonic(
+		"dev0", cryptokeyring.English, sdk.FullFundraiserPath, cryptokeyring.DefaultBIP39Passphrase, evmoshd.EthSecp256k1,
+	)
+	require.NoError(t, err, "expected no error creating key")
+
+	accounts, err := utils.ListAccounts(bin, home)
+	require.NoError(t, err, "expected no error listing accounts")
+	require.Len(t, accounts, 1, "expected one account")
+
+	account := accounts[0]
+	require.Equal(t, "dev0", account.Name, "expected different name")
+	require.Equal(t, "local", account.Type, "expected different type")
+	require.Equal(t, home, account.KeyringHome, "expected different keyring home")
+	require.Equal(t, "/ethermint.crypto.v1.ethsecp256k1.PubKey", account.PubKeyType, "expected different pubkey type")
+
+	addressBz, err := sdk.GetFromBech32(account.Address, utils.Bech32Prefix)
+	require.NoError(t, err, "expected valid bech32 address")
+	require.Equal(t, common.BytesToAddress(addressBz).Hex(), account.HexAddress, "expected matching hex address")
+}
+
+func TestGetKeyringPassphrase(t *testing.T) {
+	t.Parallel()
+
+	passphraseFile := filepath.Join(t.TempDir(), "passphrase")
+	require.NoError(t, os.WriteFile(passphraseFile, []byte("secret\n"), 0o600), "expected no error writing file")
+
+	passphrase, err := utils.GetKeyringPassphrase(passphraseFile)
+	require.NoError(t, err, "expected no error reading passphrase")
+	require.Equal(t, "secret", passphrase, "expected trailing newline to be removed")
+
+	_, err = utils.GetKeyringPassphrase(filepath.Join(t.TempDir(), "missing"))
+	require.ErrorContains(t, err, "error reading keyring passphrase file")
+}
@@ -1,11 +1,10 @@
 package utils
 
 import (
-	"encoding/json"
 	"errors"
-	"fmt"
+	"os"
+	"path/filepath"
 
-	cryptokeyring "github.com/cosmos/cosmos-sdk/crypto/keyring"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -16,37 +15,92 @@ type Account struct {
 	Address     string                    `json:"address"`
 	PubKey      string                    `json:"pubkey"`
 	Delegations []stakingtypes.Delegation `json:"delegations"`
+	// KeyringHome is the home directory of the keyring containing the account.
+	KeyringHome string `json:"keyring_home"`
+	// PubKeyType is the type URL of the public key, e.g. "/ethermint.crypto.v1.ethsecp256k1.PubKey".
+	PubKeyType string `json:"pubkey_type"`
+	// HexAddress is the EIP-55 encoded hex address of the account.
+	HexAddress string `json:"hex_address"`
 }
 
 // getAccounts is a method to retrieve the binaries keys from the configured
-// keyring backend and stores it in the Binary struct.
+// keyring backend and stores it in the Binary struct. The keyring is read in-process,
+// so that no password prompt of the binary is needed for the file backend.
+//
+// If the home directory contains a local testnet, the keys of all node homes are retrieved.
 func (bin *Binary) getAccounts() error {
-	out, err := ExecuteBinaryCmd(bin, BinaryCmdArgs{
-		Subcommand: []string{"keys", "list", "--output=json", "--home", bin.Config.Home},
-	})
+	keyringHomes, err := GetKeyringHomes(bin.Config.Home)
 	if err != nil {
 		return err
 	}
 
-	accounts, err := ParseAccountsFromOut(out)
-	if err != nil {
-		return err
-	}
+	bin.Accounts = nil
 
-	bin.Accounts = accounts
+	for _, keyringHome := range keyringHomes {
+		accounts, err := ListAccounts(bin, keyringHome)
+		if err != nil {
+			return err
+		}
+
+		bin.Accounts = append(bin.Accounts, accounts...)
+	}
 
 	return nil
 }
 
-// FilterAccountsWithDelegations filters the given list of accounts for those, which are used for staking.
+// GetKeyringHome returns the home directory of the keyring, that contains the account
+// with the given name. If the account is not found, the configured home directory is returned.
+func (bin *Binary) GetKeyringHome(name string) string {
+	for _, acc := range bin.Accounts {
+		if acc.Name == name && acc.KeyringHome != "" {
+			return acc.KeyringHome
+		}
+	}
+
+	return bin.Config.Home
+}
+
+// GetKeyringHomes returns the home directories of all keyrings, that belong to the given home directory.
+// For a local testnet, these are the home directories of all nodes listed in the testnet manifest.
+// Otherwise, it is only the given home directory.
+func GetKeyringHomes(home string) ([]string, error) {
+	manifestPath := filepath.Join(home, TestnetManifestFile)
+	if _, err := os.Stat(manifestPath); os.IsNotExist(err) {
+		return []string{home}, nil
+	}
+
+	var manifest struct {
+		Nodes []struct {
+			Home string `json:"home"`
+		} `json:"nodes"`
+	}
+
+	if err := ReadJSONFile(manifestPath, &manifest); err != nil {
+		return nil, err
+	}
+
+	homes := make([]string, 0, len(manifest.Nodes))
+	for _, node := range manifest.Nodes {
+		homes = append(homes, node.Home)
+	}
+
+	return homes, nil
+}
+
+// FilterAccountsWithDelegations filters the selected accounts for those, which are used for staking.
 func FilterAccountsWithDelegations(bin *Binary) ([]Account, error) {
 	var stakingAccs []Account
 
-	if len(bin.Accounts) == 0 {
+	accounts, err := bin.GetSelectedAccounts()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(accounts) == 0 {
 		return nil, errors.New("no accounts found")
 	}
 
-	for _, acc := range bin.Accounts {
+	for _, acc := range accounts {
 		out, err := ExecuteQuery(bin, QueryArgs{
 			Subcommand: []string{"query", "staking", "delegations", acc.Address, "--output=json"},
 		})
@@ -67,27 +121,3 @@ func FilterAccountsWithDelegations(bin *Binary) ([]Account, error) {
 
 	return stakingAccs, nil
 }
-
-// ParseAccountsFromOut parses the keys from the given output from the keys list command.
-func ParseAccountsFromOut(out string) ([]Account, error) {
-	var (
-		accounts = make([]Account, 0)
-		keys     = make([]cryptokeyring.KeyOutput, 0)
-	)
-
-	err := json.Unmarshal([]byte(out), &keys)
-	if err != nil {
-		return nil, fmt.Errorf("error unmarshalling keys: %w", err)
-	}
-
-	for _, key := range keys {
-		accounts = append(accounts, Account{
-			Name:    key.Name,
-			Type:    key.Type,
-			Address: key.Address,
-			PubKey:  key.PubKey,
-		})
-	}
-
-	return accounts, nil
-}
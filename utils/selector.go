@@ -0,0 +1,115 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// GetSelectedAccounts returns the accounts of the keyring, that are selected to sign transactions.
+// If an account is selected with --from, only this account is returned. Otherwise, the accounts
+// matching --keys are returned, which defaults to all accounts, without the ones matching --exclude.
+func (bin *Binary) GetSelectedAccounts() ([]Account, error) {
+	if bin.Config.From != "" {
+		account, err := FindAccount(bin.Accounts, bin.Config.From)
+		if err != nil {
+			return nil, err
+		}
+
+		return []Account{account}, nil
+	}
+
+	return SelectAccounts(bin.Accounts, bin.Config.Keys, bin.Config.Exclude)
+}
+
+// GetSender returns the account, that is used to sign transactions sent from a single account.
+// This is the account selected with --from or the first of the selected accounts.
+func (bin *Binary) GetSender() (Account, error) {
+	accounts, err := bin.GetSelectedAccounts()
+	if err != nil {
+		return Account{}, err
+	}
+
+	if len(accounts) == 0 {
+		return Account{}, errors.New("no accounts selected")
+	}
+
+	return accounts[0], nil
+}
+
+// FindAccount returns the account with the given name or address.
+func FindAccount(accounts []Account, key string) (Account, error) {
+	for _, acc := range accounts {
+		if acc.Name == key || MatchesAddress(acc, key) {
+			return acc, nil
+		}
+	}
+
+	return Account{}, fmt.Errorf("key %q not found in keyring", key)
+}
+
+// SelectAccounts returns the accounts matching any of the given keys and none of the excluded keys.
+// Keys can be names, glob patterns of names (e.g. "dev*") or addresses. If no keys are given,
+// all accounts are selected. It fails if one of the given keys matches none of the accounts.
+func SelectAccounts(accounts []Account, keys, exclude []string) ([]Account, error) {
+	for _, key := range keys {
+		if !matchesAny(accounts, key) {
+			return nil, fmt.Errorf("key %q not found in keyring", key)
+		}
+	}
+
+	selected := make([]Account, 0, len(accounts))
+
+	for _, acc := range accounts {
+		if len(keys) > 0 && !MatchesAnyKey(acc, keys) {
+			continue
+		}
+
+		if MatchesAnyKey(acc, exclude) {
+			continue
+		}
+
+		selected = append(selected, acc)
+	}
+
+	return selected, nil
+}
+
+// MatchesAnyKey returns whether the account matches any of the given keys.
+func MatchesAnyKey(acc Account, keys []string) bool {
+	for _, key := range keys {
+		if MatchesKey(acc, key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MatchesKey returns whether the account matches the given name, glob pattern of names or address.
+func MatchesKey(acc Account, key string) bool {
+	if MatchesAddress(acc, key) {
+		return true
+	}
+
+	matched, err := path.Match(key, acc.Name)
+
+	return err == nil && matched
+}
+
+// MatchesAddress returns whether the given bech32 or hex address is the address of the account.
+func MatchesAddress(acc Account, address string) bool {
+	return address != "" && (address == acc.Address || strings.EqualFold(address, acc.HexAddress))
+}
+
+// matchesAny returns whether any of the accounts matches the given key.
+func matchesAny(accounts []Account, key string) bool {
+	for _, acc := range accounts {
+		if MatchesKey(acc, key) {
+			return true
+		}
+	}
+
+	return false
+}
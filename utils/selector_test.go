@@ -0,0 +1,143 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectAccounts(t *testing.T) {
+	t.Parallel()
+
+	accounts := []utils.Account{
+		{Name: "dev0", Address: "evmos1dev0", HexAddress: "0xAbC0"},
+		{Name: "dev1", Address: "evmos1dev1", HexAddress: "0xAbC1"},
+		{Name: "validator", Address: "evmos1val", HexAddress: "0xAbC2"},
+	}
+
+	testcases := []struct {
+		name        string
+		keys        []string
+		exclude     []string
+		expNames    []string
+		expError    bool
+		errContains string
+	}{
+		{
+			name:     "pass - all accounts by default",
+			expNames: []string{"dev0", "dev1", "validator"},
+		},
+		{
+			name:     "pass - glob pattern",
+			keys:     []string{"dev*"},
+			expNames: []string{"dev0", "dev1"},
+		},
+		{
+			name:     "pass - bech32 and hex addresses",
+			keys:     []string{"evmos1val", "0xabc1"},
+			expNames: []string{"dev1", "validator"},
+		},
+		{
+			name:     "pass - exclude",
+			exclude:  []string{"dev0"},
+			expNames: []string{"dev1", "validator"},
+		},
+		{
+			name:     "pass - exclude pattern from selected keys",
+			keys:     []string{"dev*"},
+			exclude:  []string{"*1"},
+			expNames: []string{"dev0"},
+		},
+		{
+			name:        "fail - unknown key",
+			keys:        []string{"dev0", "unknown"},
+			expError:    true,
+			errContains: `key "unknown" not found in keyring`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			selected, err := utils.SelectAccounts(accounts, tc.keys, tc.exclude)
+			if tc.expError {
+				require.ErrorContains(t, err, tc.errContains, "expected different error")
+
+				return
+			}
+
+			require.NoError(t, err, "expected no error selecting accounts")
+
+			names := make([]string, 0, len(selected))
+			for _, acc := range selected {
+				names = append(names, acc.Name)
+			}
+
+			require.Equal(t, tc.expNames, names, "expected different accounts")
+		})
+	}
+}
+
+func TestGetSender(t *testing.T) {
+	t.Parallel()
+
+	accounts := []utils.Account{
+		{Name: "dev0", Address: "evmos1dev0"},
+		{Name: "dev1", Address: "evmos1dev1"},
+	}
+
+	testcases := []struct {
+		name        string
+		config      utils.BinaryConfig
+		expSender   string
+		expError    bool
+		errContains string
+	}{
+		{
+			name:      "pass - first account by default",
+			expSender: "dev0",
+		},
+		{
+			name:      "pass - from address",
+			config:    utils.BinaryConfig{From: "evmos1dev1"},
+			expSender: "dev1",
+		},
+		{
+			name:      "pass - first of the selected keys",
+			config:    utils.BinaryConfig{Exclude: []string{"dev0"}},
+			expSender: "dev1",
+		},
+		{
+			name:        "fail - unknown from",
+			config:      utils.BinaryConfig{From: "dev2"},
+			expError:    true,
+			errContains: `key "dev2" not found in keyring`,
+		},
+		{
+			name:        "fail - no selected accounts",
+			config:      utils.BinaryConfig{Exclude: []string{"dev*"}},
+			expError:    true,
+			errContains: "no accounts selected",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			bin := &utils.Binary{Accounts: accounts, Config: tc.config}
+
+			sender, err := bin.GetSender()
+			if tc.expError {
+				require.ErrorContains(t, err, tc.errContains, "expected different error")
+
+				return
+			}
+
+			require.NoError(t, err, "expected no error getting sender")
+			require.Equal(t, tc.expSender, sender.Name, "expected different sender")
+		})
+	}
+}
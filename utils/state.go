@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// stateDirName is the name of the directory inside the binary's home directory,
+// which is used to store the state of the evmos-utils commands.
+const stateDirName = "evmos-utils"
+
+// GetStateDir returns the directory in the binary's home directory, where
+// the tool stores its local state.
+func GetStateDir(bin *Binary) string {
+	return filepath.Join(bin.Config.Home, stateDirName)
+}
+
+// WriteJSONFile marshals the given value and writes it to the given path,
+// creating the parent directories if necessary.
+func WriteJSONFile(path string, value interface{}) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("error creating directory for %s: %w", path, err)
+	}
+
+	bz, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshalling json: %w", err)
+	}
+
+	if err = os.WriteFile(path, bz, 0o600); err != nil {
+		return fmt.Errorf("error writing file %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// ReadJSONFile reads the file at the given path and unmarshals its contents
+// into the given value.
+func ReadJSONFile(path string, value interface{}) error {
+	//#nosec G304 // path is built internally from the configured home directory
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading file %s: %w", path, err)
+	}
+
+	if err = json.Unmarshal(bz, value); err != nil {
+		return fmt.Errorf("error unmarshalling %s: %w", path, err)
+	}
+
+	return nil
+}
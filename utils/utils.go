@@ -15,7 +15,9 @@ import (
 // QueryArgs are the arguments passed to a CLI query.
 type QueryArgs struct {
 	Subcommand []string
-	Quiet      bool
+	// Height is the block height to query the state at. If zero, the latest state is queried.
+	Height int
+	Quiet  bool
 }
 
 // ExecuteQueryCmd executes a query command.
@@ -23,6 +25,10 @@ func ExecuteQuery(bin *Binary, args QueryArgs) (string, error) {
 	queryCommand := args.Subcommand
 	queryCommand = append(queryCommand, "--node", bin.Config.Node)
 
+	if args.Height > 0 {
+		queryCommand = append(queryCommand, "--height", strconv.Itoa(args.Height))
+	}
+
 	return ExecuteBinaryCmd(bin, BinaryCmdArgs{
 		Subcommand: queryCommand,
 		Quiet:      args.Quiet,
@@ -37,11 +43,15 @@ type TxArgs struct {
 }
 
 // ExecuteTx executes a transaction using the given binary.
+//
+// If a fee granter is configured, the fees are paid from its fee allowance for the sender.
+// If the binary is configured to run in dry-run mode, the transaction is only printed
+// and an empty output is returned.
 func ExecuteTx(bin *Binary, args TxArgs) (string, error) {
 	txCommand := args.Subcommand
 	txCommand = append(txCommand,
 		"--node", bin.Config.Node,
-		"--home", bin.Config.Home,
+		"--home", bin.GetKeyringHome(args.From),
 		"--from", args.From,
 		"--keyring-backend", bin.Config.KeyringBackend,
 		"--gas", "auto",
@@ -51,6 +61,14 @@ func ExecuteTx(bin *Binary, args TxArgs) (string, error) {
 		"-y",
 	)
 
+	if bin.Config.FeeGranter != "" {
+		txCommand = append(txCommand, "--fee-granter", bin.Config.FeeGranter)
+	}
+
+	if bin.Config.DryRun {
+		return "", recordDryRunTx(bin, txCommand)
+	}
+
 	return ExecuteBinaryCmd(bin, BinaryCmdArgs{
 		Subcommand: txCommand,
 		Quiet:      args.Quiet,
@@ -69,6 +87,9 @@ func ExecuteBinaryCmd(bin *Binary, args BinaryCmdArgs) (string, error) {
 
 	//#nosec G204 // no risk of injection here because only internal commands are passed
 	cmd := exec.Command(bin.Config.Appd, fullCommand...)
+	if input := bin.getPassphraseInput(); input != nil {
+		cmd.Stdin = input
+	}
 
 	output, err := cmd.CombinedOutput()
 	if err != nil && !args.Quiet {
@@ -174,9 +195,15 @@ func GetEventsFromTxResponse(cdc *codec.ProtoCodec, out string) ([]sdk.StringEve
 }
 
 // GetTxHashFromTxResponse parses the transaction hash from the given response.
+//
+// NOTE: when using `--gas auto`, the output contains the gas estimate before the
+// JSON response, so only the last line of the output is used.
 func GetTxHashFromTxResponse(cdc *codec.ProtoCodec, out string) (string, error) {
 	var txHash sdk.TxResponse
 
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	out = lines[len(lines)-1]
+
 	err := cdc.UnmarshalJSON([]byte(out), &txHash)
 	if err != nil {
 		return "", fmt.Errorf("error unpacking transaction hash from json: %w", err)
@@ -192,13 +219,29 @@ func GetTxHashFromTxResponse(cdc *codec.ProtoCodec, out string) (string, error)
 // WaitNBlocks waits for the specified amount of blocks being produced
 // on the connected network.
 func WaitNBlocks(bin *Binary, nBlocks int) error {
+	return WaitNBlocksWithTimeout(bin, nBlocks, 0)
+}
+
+// WaitNBlocksWithTimeout waits for the specified amount of blocks being produced
+// on the connected network. If the blocks are not produced within the given timeout,
+// an error is returned. A timeout of zero means waiting indefinitely.
+func WaitNBlocksWithTimeout(bin *Binary, nBlocks int, timeout time.Duration) error {
 	currentHeight, err := GetCurrentHeight(bin)
 	if err != nil {
 		return err
 	}
 
+	return WaitForHeight(bin, currentHeight+nBlocks, timeout)
+}
+
+// WaitForHeight waits until the connected network has reached the given block height.
+// If the height is not reached within the given timeout, an error is returned.
+// A timeout of zero means waiting indefinitely.
+func WaitForHeight(bin *Binary, targetHeight int, timeout time.Duration) error {
+	start := time.Now()
+
 	for {
-		bin.Logger.Debug().Msgf("waiting for %d blocks\n", nBlocks)
+		bin.Logger.Debug().Msgf("waiting for height %d\n", targetHeight)
 		time.Sleep(2 * time.Second)
 
 		height, err := GetCurrentHeight(bin)
@@ -206,9 +249,13 @@ func WaitNBlocks(bin *Binary, nBlocks int) error {
 			return err
 		}
 
-		if height >= currentHeight+nBlocks {
+		if height >= targetHeight {
 			break
 		}
+
+		if timeout > 0 && time.Since(start) > timeout {
+			return fmt.Errorf("height %d not reached after %s; current height: %d", targetHeight, timeout, height)
+		}
 	}
 
 	return nil
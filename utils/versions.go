@@ -0,0 +1,294 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// versionsDirName is the name of the directory in the user's home directory,
+	// in which the registered binaries are stored.
+	versionsDirName = ".evmos-utils/versions"
+	// versionRegistryFile is the name of the file containing the registry of binaries.
+	versionRegistryFile = "registry.json"
+	// versionSeparator separates the name and version of a registered binary, e.g. "evmosd@v16.0.3".
+	versionSeparator = "@"
+)
+
+// BinaryVersion is a binary stored in the version registry.
+type BinaryVersion struct {
+	// Name is the name of the binary, e.g. "evmosd".
+	Name string `json:"name"`
+	// Version is the version reported by the binary, e.g. "v16.0.3".
+	Version string `json:"version"`
+	// Path is the path of the stored binary.
+	Path string `json:"path"`
+	// Checksum is the SHA256 checksum of the stored binary.
+	Checksum string `json:"checksum"`
+	// VersionInfo is the output of the binary's "version --long" command.
+	VersionInfo string `json:"version_info"`
+	// AddedAt is the time the binary was added to the registry.
+	AddedAt time.Time `json:"added_at"`
+}
+
+// Ref returns the reference of the binary, that can be passed with --bin, e.g. "evmosd@v16.0.3".
+func (v BinaryVersion) Ref() string {
+	return v.Name + versionSeparator + v.Version
+}
+
+// Verify checks that the stored binary still matches the recorded checksum.
+func (v BinaryVersion) Verify() error {
+	checksum, err := getFileChecksum(v.Path)
+	if err != nil {
+		return err
+	}
+
+	if checksum != v.Checksum {
+		return fmt.Errorf("checksum of %s does not match; the binary was modified", v.Ref())
+	}
+
+	return nil
+}
+
+// VersionRegistry keeps track of versioned binaries stored in a managed directory.
+type VersionRegistry struct {
+	// Dir is the directory the binaries and the registry are stored in.
+	Dir string `json:"-"`
+	// Versions are the registered binaries.
+	Versions []BinaryVersion `json:"versions"`
+	// Selected maps the names of binaries to the version, that is used when passing only the name with --bin.
+	Selected map[string]string `json:"selected"`
+}
+
+// GetVersionsDir returns the managed directory of the version registry in the user's home directory.
+func GetVersionsDir() (string, error) {
+	userHome, err := os.UserHomeDir()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get user home dir")
+	}
+
+	return filepath.Join(userHome, versionsDirName), nil
+}
+
+// LoadVersionRegistry loads the version registry from the given directory.
+// If no registry exists yet, an empty registry is returned.
+func LoadVersionRegistry(dir string) (*VersionRegistry, error) {
+	registry := &VersionRegistry{Dir: dir, Selected: make(map[string]string)}
+
+	path := filepath.Join(dir, versionRegistryFile)
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return registry, nil
+	}
+
+	if err := ReadJSONFile(path, registry); err != nil {
+		return nil, errors.Wrap(err, "error reading version registry")
+	}
+
+	if registry.Selected == nil {
+		registry.Selected = make(map[string]string)
+	}
+
+	return registry, nil
+}
+
+// Save writes the version registry to its directory.
+func (r *VersionRegistry) Save() error {
+	return WriteJSONFile(filepath.Join(r.Dir, versionRegistryFile), r)
+}
+
+// Add copies the binary at the given path into the managed directory and registers it
+// with the version it reports. If the name is empty, the file name of the binary is used.
+func (r *VersionRegistry) Add(path, name string) (BinaryVersion, error) {
+	if name == "" {
+		name = filepath.Base(path)
+	}
+
+	reportedVersion, err := GetBinaryVersion(path)
+	if err != nil {
+		return BinaryVersion{}, err
+	}
+
+	version := "v" + strings.TrimPrefix(reportedVersion, "v")
+
+	if _, err = r.Get(name, version); err == nil {
+		return BinaryVersion{}, fmt.Errorf("%s%s%s is already registered", name, versionSeparator, version)
+	}
+
+	versionInfo, err := GetBinaryVersionInfo(path)
+	if err != nil {
+		return BinaryVersion{}, err
+	}
+
+	targetPath := filepath.Join(r.Dir, name, version, name)
+
+	checksum, err := copyBinary(path, targetPath)
+	if err != nil {
+		return BinaryVersion{}, err
+	}
+
+	binaryVersion := BinaryVersion{
+		Name:        name,
+		Version:     version,
+		Path:        targetPath,
+		Checksum:    checksum,
+		VersionInfo: versionInfo,
+		AddedAt:     time.Now().UTC(),
+	}
+
+	r.Versions = append(r.Versions, binaryVersion)
+	sort.Slice(r.Versions, func(i, j int) bool {
+		return r.Versions[i].Ref() < r.Versions[j].Ref()
+	})
+
+	return binaryVersion, nil
+}
+
+// Get returns the registered binary with the given name and version.
+func (r *VersionRegistry) Get(name, version string) (BinaryVersion, error) {
+	version = "v" + strings.TrimPrefix(version, "v")
+
+	for _, binaryVersion := range r.Versions {
+		if binaryVersion.Name == name && binaryVersion.Version == version {
+			return binaryVersion, nil
+		}
+	}
+
+	return BinaryVersion{}, fmt.Errorf("%s%s%s is not registered", name, versionSeparator, version)
+}
+
+// GetByRef returns the registered binary for the given reference, e.g. "evmosd@v16.0.3".
+func (r *VersionRegistry) GetByRef(ref string) (BinaryVersion, error) {
+	name, version, found := strings.Cut(ref, versionSeparator)
+	if !found || name == "" || version == "" {
+		return BinaryVersion{}, fmt.Errorf("invalid binary reference %q; please use the format NAME@VERSION", ref)
+	}
+
+	return r.Get(name, version)
+}
+
+// Use selects the version of the given reference, so that it is used when passing only its name with --bin.
+func (r *VersionRegistry) Use(ref string) (BinaryVersion, error) {
+	binaryVersion, err := r.GetByRef(ref)
+	if err != nil {
+		return BinaryVersion{}, err
+	}
+
+	r.Selected[binaryVersion.Name] = binaryVersion.Version
+
+	return binaryVersion, nil
+}
+
+// Resolve returns the path of the binary to execute for the given value of --bin.
+// References like "evmosd@v16.0.3" are resolved to the registered binary, names of binaries
+// with a selected version are resolved to the selected binary and all other values are returned as is.
+// Registered binaries are only returned if they still match their recorded checksum.
+func (r *VersionRegistry) Resolve(appd string) (string, error) {
+	var (
+		binaryVersion BinaryVersion
+		err           error
+	)
+
+	version, selected := r.Selected[appd]
+
+	switch {
+	case IsBinaryRef(appd):
+		binaryVersion, err = r.GetByRef(appd)
+		if err != nil {
+			return "", err
+		}
+	case selected:
+		binaryVersion, err = r.Get(appd, version)
+		if err != nil {
+			return "", errors.Wrapf(err, "selected version of %s not found", appd)
+		}
+	default:
+		return appd, nil
+	}
+
+	if err = binaryVersion.Verify(); err != nil {
+		return "", err
+	}
+
+	return binaryVersion.Path, nil
+}
+
+// IsBinaryRef returns whether the given value of --bin is a reference to a registered binary,
+// e.g. "evmosd@v16.0.3". Paths are never references, even if they contain the version separator.
+func IsBinaryRef(appd string) bool {
+	return strings.Contains(appd, versionSeparator) && !strings.ContainsAny(appd, "/"+string(filepath.Separator))
+}
+
+// ResolveBinary resolves the given value of --bin using the version registry in the user's home directory.
+func ResolveBinary(appd string) (string, error) {
+	dir, err := GetVersionsDir()
+	if err != nil {
+		return "", err
+	}
+
+	registry, err := LoadVersionRegistry(dir)
+	if err != nil {
+		return "", err
+	}
+
+	return registry.Resolve(appd)
+}
+
+// copyBinary copies the binary at the given path to the target path and returns its SHA256 checksum.
+func copyBinary(path, targetPath string) (checksum string, err error) {
+	//#nosec G304 // binary path is explicitly passed by the user
+	src, err := os.Open(path)
+	if err != nil {
+		return "", errors.Wrap(err, "error opening binary")
+	}
+
+	defer func() {
+		if closeErr := src.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	if err = os.MkdirAll(filepath.Dir(targetPath), 0o750); err != nil {
+		return "", errors.Wrap(err, "error creating version directory")
+	}
+
+	//#nosec G302 G304 // binary has to be executable and the path is built from the managed directory
+	dst, err := os.OpenFile(targetPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o700)
+	if err != nil {
+		return "", errors.Wrap(err, "error creating binary")
+	}
+
+	hash := sha256.New()
+
+	_, err = io.Copy(io.MultiWriter(dst, hash), src)
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return "", errors.Wrap(err, "error copying binary")
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// getFileChecksum returns the SHA256 checksum of the file at the given path.
+func getFileChecksum(path string) (string, error) {
+	//#nosec G304 // path is built from the managed directory
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return "", errors.Wrap(err, "error reading binary")
+	}
+
+	checksum := sha256.Sum256(bz)
+
+	return hex.EncodeToString(checksum[:]), nil
+}
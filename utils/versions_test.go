@@ -0,0 +1,65 @@
+package utils_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/MalteHerrmann/evmos-utils/testutil"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionRegistry(t *testing.T) {
+	t.Parallel()
+
+	registryDir := t.TempDir()
+
+	registry, err := utils.LoadVersionRegistry(registryDir)
+	require.NoError(t, err, "unexpected error loading empty registry")
+
+	binaryVersion, err := registry.Add(testutil.WriteFakeBinary(t, t.TempDir(), "16.0.3"), "")
+	require.NoError(t, err, "unexpected error adding binary")
+	require.Equal(t, "evmosd@v16.0.3", binaryVersion.Ref(), "expected different reference")
+	require.Contains(t, binaryVersion.VersionInfo, "name: evmos", "expected detailed version information")
+	require.NoError(t, binaryVersion.Verify(), "expected stored binary to match checksum")
+
+	_, err = registry.Add(testutil.WriteFakeBinary(t, t.TempDir(), "v16.0.3"), "")
+	require.ErrorContains(t, err, "already registered", "expected error adding the same version twice")
+
+	_, err = registry.Add(testutil.WriteFakeBinary(t, t.TempDir(), "17.0.0"), "")
+	require.NoError(t, err, "unexpected error adding binary")
+	require.NoError(t, registry.Save(), "unexpected error saving registry")
+
+	registry, err = utils.LoadVersionRegistry(registryDir)
+	require.NoError(t, err, "unexpected error loading registry")
+	require.Len(t, registry.Versions, 2, "expected both binaries to be registered")
+
+	path, err := registry.Resolve("evmosd@16.0.3")
+	require.NoError(t, err, "unexpected error resolving reference")
+	require.Equal(t, binaryVersion.Path, path, "expected path of registered binary")
+
+	_, err = registry.Resolve("evmosd@v15.0.0")
+	require.ErrorContains(t, err, "not registered", "expected error resolving unknown version")
+
+	path, err = registry.Resolve("evmosd")
+	require.NoError(t, err, "unexpected error resolving name")
+	require.Equal(t, "evmosd", path, "expected name to be kept without a selected version")
+
+	_, err = registry.Use("evmosd@v17.0.0")
+	require.NoError(t, err, "unexpected error selecting version")
+
+	path, err = registry.Resolve("evmosd")
+	require.NoError(t, err, "unexpected error resolving name")
+	require.Equal(t, filepath.Join(registryDir, "evmosd", "v17.0.0", "evmosd"), path, "expected selected binary")
+
+	path, err = registry.Resolve("/home/a@b/evmosd")
+	require.NoError(t, err, "unexpected error resolving path")
+	require.Equal(t, "/home/a@b/evmosd", path, "expected path containing the separator to be kept")
+
+	require.NoError(t, os.WriteFile(binaryVersion.Path, []byte("modified"), 0o600), "unexpected error modifying binary")
+	require.ErrorContains(t, binaryVersion.Verify(), "does not match", "expected modified binary to be detected")
+
+	_, err = registry.Resolve("evmosd@v16.0.3")
+	require.ErrorContains(t, err, "does not match", "expected modified binary not to be resolved")
+}
@@ -0,0 +1,149 @@
+package validator
+
+import (
+	"strings"
+	"time"
+
+	"github.com/MalteHerrmann/evmos-utils/node"
+	"github.com/MalteHerrmann/evmos-utils/stake"
+	"github.com/MalteHerrmann/evmos-utils/testnet"
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/pkg/errors"
+)
+
+// CreateOptions are the options to create a new validator on a local testnet.
+type CreateOptions struct {
+	// Moniker is the moniker of the validator. If empty, the name of the node is used.
+	Moniker string
+	// Balance is the amount, that the validator key is funded with from the sender.
+	Balance string
+	// SelfDelegation is the amount, that the validator delegates to itself.
+	SelfDelegation string
+	// CommissionRate is the initial commission rate of the validator.
+	CommissionRate string
+	// CommissionMaxRate is the maximum commission rate of the validator.
+	CommissionMaxRate string
+	// CommissionMaxChangeRate is the maximum daily change of the commission rate.
+	CommissionMaxChangeRate string
+	// MinSelfDelegation is the minimum self-delegation of the validator.
+	MinSelfDelegation string
+}
+
+// Create adds a new node to the local testnet in the configured home directory and makes it a validator.
+// The new validator key is funded from the sender, the node is started as a peer of the existing nodes
+// and the validator is created with the consensus key of the new node.
+func Create(bin *utils.Binary, opts CreateOptions) (testnet.Node, error) {
+	if bin.Config.DryRun {
+		return testnet.Node{}, errors.New("creating a validator is not supported in dry-run mode")
+	}
+
+	selfDelegation, err := stake.ParseCoin(opts.SelfDelegation, bin.Config.Denom)
+	if err != nil {
+		return testnet.Node{}, errors.Wrap(err, "invalid self-delegation")
+	}
+
+	balance, err := utils.ParseAmount(opts.Balance, bin.Config.Denom)
+	if err != nil {
+		return testnet.Node{}, errors.Wrap(err, "invalid balance")
+	}
+
+	sender, err := bin.GetSender()
+	if err != nil {
+		return testnet.Node{}, err
+	}
+
+	network, err := testnet.Load(bin)
+	if err != nil {
+		return testnet.Node{}, errors.Wrap(err, "creating a validator requires a local testnet")
+	}
+
+	n, err := testnet.AddNode(bin, network)
+	if err != nil {
+		return testnet.Node{}, err
+	}
+
+	if opts.Moniker == "" {
+		opts.Moniker = n.Name
+	}
+
+	// NOTE: the node is added to the manifest right away, so that it is managed with the testnet
+	// even if creating the validator fails
+	network.Nodes = append(network.Nodes, n)
+	if err = testnet.Save(bin, network); err != nil {
+		return testnet.Node{}, err
+	}
+
+	bin.Logger.Info().Msgf("funding %s with %s from %s", n.KeyName, balance, sender.Name)
+
+	if err = executeTx(bin, sender.Name, "bank", "send", sender.Name, n.Address, balance.String()); err != nil {
+		return testnet.Node{}, errors.Wrapf(err, "error funding %s", n.KeyName)
+	}
+
+	nodeBin := testnet.GetNodeBinary(bin, n)
+
+	pid, err := node.Start(nodeBin)
+	if err != nil {
+		return testnet.Node{}, errors.Wrapf(err, "error starting %s", n.Name)
+	}
+
+	bin.Logger.Info().Msgf("started %s with PID %d (RPC: %s)", n.Name, pid, n.RPCAddress())
+
+	if err = node.WaitForRPC(nodeBin, time.Minute); err != nil {
+		return testnet.Node{}, errors.Wrapf(err, "error waiting for %s", n.Name)
+	}
+
+	pubKey, err := utils.ExecuteBinaryCmd(nodeBin, utils.BinaryCmdArgs{
+		Subcommand: []string{"tendermint", "show-validator", "--home", n.Home},
+	})
+	if err != nil {
+		return testnet.Node{}, errors.Wrap(err, "error getting consensus key")
+	}
+
+	// NOTE: the key of the new node has to be known to sign the transaction from its keyring
+	accounts, err := utils.ListAccounts(bin, n.Home)
+	if err != nil {
+		return testnet.Node{}, err
+	}
+
+	bin.Accounts = append(bin.Accounts, accounts...)
+
+	err = executeTx(bin, n.KeyName, "staking", "create-validator",
+		"--amount", selfDelegation.String(),
+		"--pubkey", strings.TrimSpace(pubKey),
+		"--moniker", opts.Moniker,
+		"--commission-rate", opts.CommissionRate,
+		"--commission-max-rate", opts.CommissionMaxRate,
+		"--commission-max-change-rate", opts.CommissionMaxChangeRate,
+		"--min-self-delegation", opts.MinSelfDelegation,
+	)
+	if err != nil {
+		return testnet.Node{}, errors.Wrap(err, "error creating validator")
+	}
+
+	n.Stake = selfDelegation.Amount.String()
+	network.Nodes[len(network.Nodes)-1] = n
+
+	return n, testnet.Save(bin, network)
+}
+
+// executeTx executes the given transaction of the given module from the given key
+// and waits for it to be included in a block.
+func executeTx(bin *utils.Binary, from, module string, args ...string) error {
+	subcommand := append([]string{"tx", module}, args...)
+
+	out, err := utils.ExecuteTx(bin, utils.TxArgs{
+		Subcommand: append(subcommand, "--output", "json"),
+		From:       from,
+	})
+	if err != nil {
+		return err
+	}
+
+	if bin.Config.DryRun {
+		return nil
+	}
+
+	_, err = utils.GetTxEvents(bin, out)
+
+	return err
+}
@@ -0,0 +1,104 @@
+package validator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/pkg/errors"
+)
+
+// consensusPrefixSuffix is appended to the bech32 prefix of account addresses
+// to get the prefix of validator consensus addresses, e.g. "evmosvalcons".
+const consensusPrefixSuffix = "valcons"
+
+// Info is the status of a validator.
+type Info struct {
+	// Moniker is the moniker of the validator.
+	Moniker string `json:"moniker"`
+	// OperatorAddress is the validator operator address, e.g. "evmosvaloper1...".
+	OperatorAddress string `json:"operator_address"`
+	// ConsensusAddress is the validator consensus address, e.g. "evmosvalcons1...".
+	ConsensusAddress string `json:"consensus_address"`
+	// Status is the bond status of the validator.
+	Status string `json:"status"`
+	// VotingPower is the consensus voting power of the validator.
+	VotingPower int64 `json:"voting_power"`
+	// Jailed defines whether the validator is jailed.
+	Jailed bool `json:"jailed"`
+	// MissedBlocks is the number of missed blocks in the current signing window.
+	MissedBlocks int64 `json:"missed_blocks"`
+	// Tombstoned defines whether the validator was tombstoned for double signing.
+	Tombstoned bool `json:"tombstoned"`
+}
+
+// List returns the status of all validators including the missed blocks from the slashing signing info.
+func List(bin *utils.Binary) ([]Info, error) {
+	out, err := utils.ExecuteQuery(bin, utils.QueryArgs{
+		Subcommand: []string{"q", "staking", "validators", "--output=json"},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error querying validators")
+	}
+
+	var validators stakingtypes.QueryValidatorsResponse
+	if err = bin.Cdc.UnmarshalJSON([]byte(out), &validators); err != nil {
+		return nil, fmt.Errorf("error unmarshalling validators: %w", err)
+	}
+
+	out, err = utils.ExecuteQuery(bin, utils.QueryArgs{
+		Subcommand: []string{"q", "slashing", "signing-infos", "--output=json"},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error querying signing infos")
+	}
+
+	var signingInfos slashingtypes.QuerySigningInfosResponse
+	if err = bin.Cdc.UnmarshalJSON([]byte(out), &signingInfos); err != nil {
+		return nil, fmt.Errorf("error unmarshalling signing infos: %w", err)
+	}
+
+	return GetInfos(validators.Validators, signingInfos.Info)
+}
+
+// GetInfos combines the given validators with their signing infos.
+func GetInfos(
+	validators []stakingtypes.Validator, signingInfos []slashingtypes.ValidatorSigningInfo,
+) ([]Info, error) {
+	signingInfoByAddress := make(map[string]slashingtypes.ValidatorSigningInfo, len(signingInfos))
+	for _, signingInfo := range signingInfos {
+		signingInfoByAddress[signingInfo.Address] = signingInfo
+	}
+
+	infos := make([]Info, 0, len(validators))
+
+	for _, validator := range validators {
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return nil, errors.Wrapf(err, "error getting consensus address of %s", validator.OperatorAddress)
+		}
+
+		consAddress, err := sdk.Bech32ifyAddressBytes(utils.Bech32Prefix+consensusPrefixSuffix, consAddr)
+		if err != nil {
+			return nil, errors.Wrap(err, "error encoding consensus address")
+		}
+
+		signingInfo := signingInfoByAddress[consAddress]
+
+		infos = append(infos, Info{
+			Moniker:          validator.GetMoniker(),
+			OperatorAddress:  validator.OperatorAddress,
+			ConsensusAddress: consAddress,
+			Status:           strings.TrimPrefix(validator.GetStatus().String(), "BOND_STATUS_"),
+			VotingPower:      validator.ConsensusPower(sdk.DefaultPowerReduction),
+			Jailed:           validator.IsJailed(),
+			MissedBlocks:     signingInfo.MissedBlocksCounter,
+			Tombstoned:       signingInfo.Tombstoned,
+		})
+	}
+
+	return infos, nil
+}
@@ -0,0 +1,46 @@
+package validator_test
+
+import (
+	"testing"
+
+	"github.com/MalteHerrmann/evmos-utils/validator"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetInfos(t *testing.T) {
+	t.Parallel()
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+	operator := sdk.ValAddress(pubKey.Address())
+
+	val, err := stakingtypes.NewValidator(operator, pubKey, stakingtypes.Description{Moniker: "node1"})
+	require.NoError(t, err, "expected no error creating validator")
+
+	val.Status = stakingtypes.Bonded
+	val.Jailed = true
+	val.Tokens = sdk.DefaultPowerReduction.MulRaw(42)
+
+	consAddress, err := sdk.Bech32ifyAddressBytes("evmosvalcons", pubKey.Address())
+	require.NoError(t, err, "expected no error encoding consensus address")
+
+	signingInfos := []slashingtypes.ValidatorSigningInfo{
+		{Address: consAddress, MissedBlocksCounter: 7},
+		{Address: "evmosvalcons1other", MissedBlocksCounter: 3},
+	}
+
+	infos, err := validator.GetInfos([]stakingtypes.Validator{val}, signingInfos)
+	require.NoError(t, err, "expected no error getting infos")
+	require.Equal(t, []validator.Info{{
+		Moniker:          "node1",
+		OperatorAddress:  operator.String(),
+		ConsensusAddress: consAddress,
+		Status:           "BONDED",
+		VotingPower:      42,
+		Jailed:           true,
+		MissedBlocks:     7,
+	}}, infos, "expected different infos")
+}
@@ -0,0 +1,52 @@
+package validator
+
+import (
+	"github.com/MalteHerrmann/evmos-utils/utils"
+	"github.com/pkg/errors"
+)
+
+// EditOptions are the options to edit a validator. Empty values are not changed.
+type EditOptions struct {
+	// Moniker is the new moniker of the validator.
+	Moniker string
+	// Details are the new details of the validator.
+	Details string
+	// CommissionRate is the new commission rate of the validator.
+	CommissionRate string
+}
+
+// Edit edits the validator operated by the sender.
+func Edit(bin *utils.Binary, opts EditOptions) error {
+	sender, err := bin.GetSender()
+	if err != nil {
+		return err
+	}
+
+	args := []string{"edit-validator"}
+
+	for _, flag := range [][2]string{
+		{"--moniker", opts.Moniker},
+		{"--details", opts.Details},
+		{"--commission-rate", opts.CommissionRate},
+	} {
+		if flag[1] != "" {
+			args = append(args, flag[0], flag[1])
+		}
+	}
+
+	if len(args) == 1 {
+		return errors.New("nothing to edit; please pass at least one of moniker, details or commission rate")
+	}
+
+	return errors.Wrapf(executeTx(bin, sender.Name, "staking", args...), "error editing validator of %s", sender.Name)
+}
+
+// Unjail unjails the validator operated by the sender.
+func Unjail(bin *utils.Binary) error {
+	sender, err := bin.GetSender()
+	if err != nil {
+		return err
+	}
+
+	return errors.Wrapf(executeTx(bin, sender.Name, "slashing", "unjail"), "error unjailing validator of %s", sender.Name)
+}